@@ -0,0 +1,18 @@
+action "atlassian-operations_heartbeat_ping" "example" {
+  config {
+    team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    name    = "deployment-heartbeat"
+  }
+}
+
+# Ping the heartbeat right after it is created, so that it doesn't alert before the monitored service reports in
+resource "terraform_data" "heartbeat_ping" {
+  input = atlassian-operations_heartbeat.example.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.atlassian-operations_heartbeat_ping.example]
+    }
+  }
+}
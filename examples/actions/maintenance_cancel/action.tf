@@ -0,0 +1,9 @@
+action "atlassian-operations_maintenance_cancel" "example" {
+  config {
+    id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    team_id = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+  }
+}
+
+# The action can also be invoked on its own:
+# terraform apply -invoke=action.atlassian-operations_maintenance_cancel.example
@@ -0,0 +1,24 @@
+action "atlassian-operations_send_test_alert" "example" {
+  config {
+    api_key     = atlassian-operations_api_integration.example.api_key
+    message     = "Test alert after routing changes"
+    description = "Verifies the routing rules and escalations of the team"
+    priority    = "P5"
+    tags        = ["terraform", "test"]
+    details = {
+      triggered_by = "terraform"
+    }
+  }
+}
+
+# Send a test alert whenever the routing rules of the team change
+resource "terraform_data" "routing_change" {
+  triggers_replace = [atlassian-operations_routing_rule.example]
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.atlassian-operations_send_test_alert.example]
+    }
+  }
+}
@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+# Get Atlassian Operations team Alert Policy by name
+data "atlassian-operations_alert_policy" "example" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name    = "Test alert policy"
+}
+
+# Get Atlassian Operations global Alert Policy by name
+data "atlassian-operations_alert_policy" "global" {
+  name = "Test global alert policy"
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+# Get Atlassian Operations Escalation by name
+data "atlassian-operations_escalation" "example" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name    = "Test escalation"
+}
+
+# Get Atlassian Operations Escalation by id
+data "atlassian-operations_escalation" "by_id" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  id      = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+}
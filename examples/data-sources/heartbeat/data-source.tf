@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+# Get Atlassian Operations Heartbeat by name
+data "atlassian-operations_heartbeat" "example" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name    = "Test heartbeat"
+}
+
+# Flag the heartbeat when it is enabled but expired
+check "heartbeat_is_alive" {
+  assert {
+    condition     = !(data.atlassian-operations_heartbeat.example.enabled && data.atlassian-operations_heartbeat.example.expired)
+    error_message = "The heartbeat expired, last ping received at ${data.atlassian-operations_heartbeat.example.last_ping_time}"
+  }
+}
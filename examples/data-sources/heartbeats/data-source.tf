@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+# Get the Atlassian Operations Heartbeats of a team
+data "atlassian-operations_heartbeats" "example" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Flag the heartbeats which are enabled but expired
+check "heartbeats_are_alive" {
+  assert {
+    condition = length([
+      for heartbeat in data.atlassian-operations_heartbeats.example.heartbeats : heartbeat
+      if heartbeat.enabled && heartbeat.expired
+    ]) == 0
+    error_message = "Some heartbeats of the team expired"
+  }
+}
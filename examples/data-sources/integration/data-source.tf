@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+# Get the default API integration of a team
+data "atlassian-operations_integration" "example" {
+  name    = "Default API"
+  type    = "API"
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Get an integration including its API key
+data "atlassian-operations_integration" "with_key" {
+  name            = "Monitoring"
+  type            = "API"
+  include_api_key = true
+}
@@ -0,0 +1,14 @@
+resource "atlassian-operations_routing_rule" "example" {
+  name       = "Business hours routing"
+  team_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  is_default = false
+  timezone   = "Europe/Istanbul"
+  criteria = {
+    type = "match-all"
+  }
+  time_restriction = provider::atlassian-operations::business_hours("monday-friday", "09:00-17:00")
+  notify = {
+    type = "schedule"
+    id   = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+  }
+}
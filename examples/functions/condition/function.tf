@@ -0,0 +1,17 @@
+resource "atlassian-operations_routing_rule" "example" {
+  name       = "Critical errors"
+  team_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  is_default = false
+  criteria = {
+    type = "match-any-condition"
+    conditions = [
+      provider::atlassian-operations::condition("message", "contains", "error"),
+      provider::atlassian-operations::condition("priority", "equals", "P1"),
+      merge(provider::atlassian-operations::condition("extra-properties", "equals", "production"), { key = "environment" }),
+    ]
+  }
+  notify = {
+    type = "escalation"
+    id   = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+  }
+}
@@ -0,0 +1,7 @@
+list "atlassian-operations_email_integration" "example" {
+  provider = atlassian-operations
+
+  config {
+    team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+}
@@ -0,0 +1,3 @@
+list "atlassian-operations_schedule" "example" {
+  provider = atlassian-operations
+}
@@ -0,0 +1,7 @@
+list "atlassian-operations_schedule_rotation" "example" {
+  provider = atlassian-operations
+
+  config {
+    schedule_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+}
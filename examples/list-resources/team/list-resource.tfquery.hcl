@@ -0,0 +1,7 @@
+list "atlassian-operations_team" "example" {
+  provider = atlassian-operations
+
+  config {
+    organization_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+}
@@ -1,2 +1,13 @@
 # Custom Role can be imported by providing the custom role id
 terraform import atlassian-operations_custom_role.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# It can also be imported by name, preceded by a slash
+terraform import atlassian-operations_custom_role.example "/My Custom Role"
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_custom_role.example
+#   identity = {
+#     id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+#   }
+# }
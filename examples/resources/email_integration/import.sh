@@ -1,2 +1,10 @@
 # EmailIntegration can be imported by providing the schedule id
 terraform import atlassian-operations_email_integration.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_email_integration.example
+#   identity = {
+#     id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+#   }
+# }
@@ -10,15 +10,37 @@ resource "atlassian-operations_escalation" "example" {
   name        = "escalationName"
   team_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
   description = "escalation description"
-  rules = [{
-    condition   = "if-not-closed"
-    notify_type = "all"
-    delay       = 1
-    recipient = {
-      id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
-      type = "team"
+  rules = [
+    {
+      condition   = "if-not-closed"
+      notify_type = "all"
+      delay       = 1
+      recipient = {
+        id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+        type = "team"
+      }
+    },
+    {
+      # Users can be referenced by email, schedules and teams by name
+      condition   = "if-not-acked"
+      notify_type = "default"
+      delay       = 0
+      recipient = {
+        email = "olive@example.com"
+        type  = "user"
+      }
+    },
+    {
+      # next and previous only apply to schedules, users, admins, random and all only to teams
+      condition   = "if-not-acked"
+      notify_type = "next"
+      delay       = 10
+      recipient = {
+        name = "Primary on-call"
+        type = "schedule"
+      }
     }
-  }]
+  ]
   enabled = true
   repeat = {
     wait_interval          = 5
@@ -1,2 +1,15 @@
 #!/bin/bash
-terraform import atlassian-operations_heartbeat.example heartbeat_name,team_id 
\ No newline at end of file
+# Heartbeat can be imported by providing the heartbeat id and the team id, seperated by a comma
+terraform import atlassian-operations_heartbeat.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+
+# It can also be imported by name, providing the heartbeat name and the team id, seperated by a comma
+terraform import atlassian-operations_heartbeat.example heartbeat_name,team_id
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_heartbeat.example
+#   identity = {
+#     id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+#     team_id = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+#   }
+# }
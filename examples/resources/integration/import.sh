@@ -0,0 +1,10 @@
+# Integration can be imported by providing the integration id
+terraform import atlassian-operations_integration.example "df47a95c-f9ae-4ca6-873b-375fcad3cd18"
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_integration.example
+#   identity = {
+#     id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+#   }
+# }
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_integration" "prometheus" {
+  name    = "prometheus"
+  type    = "Prometheus"
+  enabled = true
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  type_specific_properties = jsonencode({
+    suppressNotifications : false
+  })
+}
+
+resource "atlassian-operations_integration" "datadog" {
+  name       = "datadog"
+  type       = "Datadog"
+  enabled    = true
+  team_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  directions = ["incoming", "outgoing"]
+}
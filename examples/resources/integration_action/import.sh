@@ -1,2 +1,11 @@
 # Integration Action can be imported by providing the notification rule id, integration-id
 terraform import atlassian-operations_integration_action.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_integration_action.example
+#   identity = {
+#     id             = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+#     integration_id = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+#   }
+# }
@@ -4,4 +4,13 @@
 terraform import atlassian-operations_maintenance.example maintenance_id
 
 # Import a team-specific maintenance window
-# terraform import atlassian-operations_maintenance.example maintenance_id,team_id 
\ No newline at end of file
+# terraform import atlassian-operations_maintenance.example maintenance_id,team_id 
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_maintenance.example
+#   identity = {
+#     id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+#     team_id = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+#   }
+# }
@@ -22,4 +22,36 @@ resource "atlassian-operations_maintenance" "example" {
     }
   }
   ]
-} 
\ No newline at end of file
+} 
+# This example demonstrates a weekly maintenance window, rolled forward to the next
+# occurrence on the first apply after the current window completes.
+resource "atlassian-operations_maintenance" "weekly" {
+  description = "Weekly database patching"
+  schedule = {
+    days       = ["sunday"]
+    start_time = "02:00"
+    timezone   = "Europe/Berlin"
+    duration   = "4h"
+  }
+
+  rules = [{
+    state = "disabled"
+    entity = {
+      id   = "integration-1234" # Replace with your integration ID
+      type = "integration"
+    }
+  }]
+}
+
+# This example demonstrates a maintenance window referencing the affected integrations and
+# policies by ID. They are expanded into rules, and checked to exist in the team at plan time.
+resource "atlassian-operations_maintenance" "typed" {
+  description = "Planned network upgrade"
+  start_date  = "2029-06-17T10:00:00Z"
+  end_date    = "2029-06-17T12:00:00Z"
+  team_id     = "your-team-id"
+
+  integration_ids = ["integration-1234"] # Replace with your integration IDs
+  policy_ids      = ["policy-1234"]      # Replace with your policy IDs
+  state           = "disabled"
+}
@@ -1,2 +1,10 @@
 # Team can be imported by providing the notification rule id
 terraform import atlassian-operations_notification_rule.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_notification_rule.example
+#   identity = {
+#     id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+#   }
+# }
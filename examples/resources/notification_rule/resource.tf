@@ -40,4 +40,25 @@ resource "atlassian-operations_notification_rule" "example" {
     loop_after = 30
     enabled    = true
   }
-}
\ No newline at end of file
+}
+resource "atlassian-operations_user_contact" "example" {
+  method  = "sms"
+  to      = "1-5555555555"
+  enabled = true
+}
+
+resource "atlassian-operations_notification_rule" "contact_id_example" {
+  name        = "Acknowledged Alert"
+  action_type = "acknowledged-alert"
+  enabled     = true
+
+  criteria = {
+    type = "match-all"
+  }
+
+  steps = [
+    {
+      contact_id = atlassian-operations_user_contact.example.id
+    }
+  ]
+}
@@ -0,0 +1,14 @@
+# The policy order of a team can be imported by providing the policy type and the team id, seperated by a comma
+terraform import atlassian-operations_policy_order.team_alert_policies "alert,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# The order of the global alert policies can be imported by providing only the policy type
+terraform import atlassian-operations_policy_order.global_alert_policies "alert"
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_policy_order.example
+#   identity = {
+#     policy_type = "alert"
+#     team_id     = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+#   }
+# }
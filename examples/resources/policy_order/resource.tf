@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+# Order of the alert policies of a team
+resource "atlassian-operations_policy_order" "team_alert_policies" {
+  team_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  policy_type = "alert"
+  policy_ids = [
+    atlassian-operations_alert_policy.critical.id,
+    atlassian-operations_alert_policy.default.id,
+  ]
+}
+
+# Order of the global alert policies
+resource "atlassian-operations_policy_order" "global_alert_policies" {
+  policy_type = "alert"
+  policy_ids = [
+    atlassian-operations_alert_policy.global_critical.id,
+    atlassian-operations_alert_policy.global_default.id,
+  ]
+}
@@ -1,2 +1,15 @@
 # Team can be imported by providing the routing rule id and the team id, seperated by a comma
 terraform import atlassian-operations_routing_rule.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# It can also be imported by name, providing the team (by id or by name) and the routing rule name, separated by a slash.
+# Looking teams up by name requires the organization_id of the provider configuration.
+terraform import atlassian-operations_routing_rule.example "My Team/My Routing Rule"
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_routing_rule.example
+#   identity = {
+#     id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+#     team_id = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+#   }
+# }
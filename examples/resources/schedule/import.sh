@@ -1,2 +1,14 @@
 # Schedule can be imported by providing the schedule id
 terraform import atlassian-operations_schedule.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# It can also be imported by name, providing the team (by id or by name) and the schedule name, separated by a slash.
+# Looking teams up by name requires the organization_id of the provider configuration.
+terraform import atlassian-operations_schedule.example "My Team/My Schedule"
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_schedule.example
+#   identity = {
+#     id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+#   }
+# }
@@ -1,2 +1,14 @@
 # Schedule Rotation can be imported by providing the rotation id and the schedule id, seperated by a comma
 terraform import atlassian-operations_schedule_rotation.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# It can also be imported by name, providing the schedule (by id or by name) and the rotation name, separated by a slash
+terraform import atlassian-operations_schedule_rotation.example "My Schedule/My Rotation"
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_schedule_rotation.example
+#   identity = {
+#     id          = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+#     schedule_id = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+#   }
+# }
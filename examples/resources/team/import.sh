@@ -1,2 +1,11 @@
 # Team can be imported by providing the team id and the organization id, seperated by a comma
 terraform import atlassian-operations_team.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_team.example
+#   identity = {
+#     id              = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+#     organization_id = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+#   }
+# }
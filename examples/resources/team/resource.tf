@@ -14,6 +14,11 @@ resource "atlassian-operations_team" "example" {
   member = [
     {
       account_id = "XXXXXX:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+      role       = "admin"
+    },
+    {
+      account_id = "XXXXXX:yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+      role       = "user"
     }
   ]
 }
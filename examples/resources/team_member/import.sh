@@ -0,0 +1,12 @@
+# Team members can be imported by providing the team id, the account id of the member and the organization id, seperated by commas
+terraform import atlassian-operations_team_member.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,XXXXXX:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_team_member.example
+#   identity = {
+#     team_id         = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+#     account_id      = "XXXXXX:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+#     organization_id = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+#   }
+# }
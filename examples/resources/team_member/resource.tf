@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id          = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  description              = "This is a team created by Terraform"
+  display_name             = "Terraform Team"
+  team_type                = "MEMBER_INVITE"
+  authoritative_membership = false
+  member = [
+    {
+      account_id = "XXXXXX:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    }
+  ]
+}
+
+resource "atlassian-operations_team_member" "example" {
+  organization_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  team_id         = atlassian-operations_team.example.id
+  account_id      = "XXXXXX:yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+}
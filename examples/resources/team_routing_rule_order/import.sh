@@ -0,0 +1,10 @@
+# The routing rule order can be imported by providing the team id
+terraform import atlassian-operations_team_routing_rule_order.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_team_routing_rule_order.example
+#   identity = {
+#     team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+#   }
+# }
@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_team_routing_rule_order" "example" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  routing_rule_ids = [
+    atlassian-operations_routing_rule.critical.id,
+    atlassian-operations_routing_rule.business_hours.id,
+  ]
+}
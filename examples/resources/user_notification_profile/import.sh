@@ -0,0 +1,13 @@
+# User Notification Profile can be imported by providing its name, its contacts as contact_name=contact_id and the
+# IDs of its notification rules, separated by commas
+terraform import atlassian-operations_user_notification_profile.example "on-call,work=xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,home=xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# With Terraform 1.12 or higher, it can also be imported with an import block using its identity:
+# import {
+#   to = atlassian-operations_user_notification_profile.example
+#   identity = {
+#     name     = "on-call"
+#     contacts = ["home=xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", "work=xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"]
+#     rule_ids = ["xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"]
+#   }
+# }
@@ -0,0 +1,63 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "atlassian/atlassian-operations"
+    }
+  }
+}
+
+# The contacts and notification rules are created for the user the provider is
+# configured with. Use a provider alias per on-call user to apply the same
+# notification setup to each of them.
+provider "atlassian-operations" {
+  alias         = "olive"
+  cloud_id      = "<YOUR_CLOUD_ID>"
+  domain_name   = "<YOUR_DOMAIN>.atlassian.net"
+  email_address = "olive@example.com"
+  token         = "<OLIVE_API_TOKEN>"
+}
+
+resource "atlassian-operations_user_notification_profile" "olive" {
+  provider = atlassian-operations.olive
+  name     = "On-call baseline"
+
+  contacts = {
+    work = {
+      method = "email"
+      to     = "olive@example.com"
+    }
+    phone = {
+      method = "sms"
+      to     = "1-5555555555"
+    }
+  }
+
+  rules = {
+    "create-alert" = {
+      steps = [
+        {
+          contact    = "work"
+          send_after = 0
+        },
+        {
+          contact    = "phone"
+          send_after = 5
+        }
+      ]
+    }
+    "assigned-alert" = {
+      steps = [
+        {
+          contact = "phone"
+        }
+      ]
+    }
+    "closed-alert" = {
+      steps = [
+        {
+          contact = "work"
+        }
+      ]
+    }
+  }
+}
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_webhook_integration" "example" {
+  name    = "webhook integration"
+  enabled = true
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  url     = "https://example.com/webhook"
+  headers = {
+    Authorization = "Bearer xxxxx"
+  }
+  add_alert_description = true
+  add_alert_details     = true
+  alert_filter = {
+    type = "match-any-condition"
+    conditions = [
+      {
+        field          = "priority"
+        operation      = "equals"
+        expected_value = "P1"
+      },
+      {
+        field          = "tags"
+        operation      = "contains"
+        expected_value = "critical"
+      }
+    ]
+  }
+}
@@ -0,0 +1,17 @@
+package dto
+
+type CreateAlertDto struct {
+	Message     string            `json:"message"`
+	Alias       string            `json:"alias,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Source      string            `json:"source,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Details     map[string]string `json:"details,omitempty"`
+	Priority    string            `json:"priority,omitempty"`
+}
+
+type CreateAlertResponseDto struct {
+	Result    string  `json:"result"`
+	Took      float64 `json:"took"`
+	RequestId string  `json:"requestId"`
+}
@@ -1,12 +1,15 @@
 package dto
 
 type HeartbeatDto struct {
+	Id            string   `json:"id,omitempty"`
 	Name          string   `json:"name"`
 	Description   string   `json:"description,omitempty"`
 	Interval      int      `json:"interval"`
 	IntervalUnit  string   `json:"intervalUnit"`
 	Enabled       bool     `json:"enabled"`
 	Status        string   `json:"status,omitempty"`
+	Expired       bool     `json:"expired,omitempty"`
+	LastPingTime  string   `json:"lastPingTime,omitempty"`
 	OwnerTeamId   string   `json:"ownerTeamId,omitempty"`
 	AlertMessage  string   `json:"alertMessage,omitempty"`
 	AlertTags     []string `json:"alertTags,omitempty"`
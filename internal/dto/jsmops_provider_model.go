@@ -9,6 +9,7 @@ type AtlassianOpsProviderModel struct {
 	emailAddress    string
 	token           string
 	orgAdminToken   string
+	organizationId  string
 	apiRetryCount   int
 	apiRetryWait    time.Duration
 	apiRetryWaitMax time.Duration
@@ -22,6 +23,7 @@ func NewAtlassianOpsProviderModel(
 	emailAddress string,
 	token string,
 	orgAdminToken string,
+	organizationId string,
 	apiRetryCount int,
 	apiRetryWait time.Duration,
 	apiRetryWaitMax time.Duration,
@@ -34,6 +36,7 @@ func NewAtlassianOpsProviderModel(
 		emailAddress:    emailAddress,
 		token:           token,
 		orgAdminToken:   orgAdminToken,
+		organizationId:  organizationId,
 		apiRetryCount:   apiRetryCount,
 		apiRetryWait:    apiRetryWait,
 		apiRetryWaitMax: apiRetryWaitMax,
@@ -65,6 +68,10 @@ func (receiver AtlassianOpsProviderModel) GetOrgAdminToken() string {
 	return receiver.orgAdminToken
 }
 
+func (receiver AtlassianOpsProviderModel) GetOrganizationId() string {
+	return receiver.organizationId
+}
+
 func (receiver AtlassianOpsProviderModel) GetApiRetryCount() int {
 	return receiver.apiRetryCount
 }
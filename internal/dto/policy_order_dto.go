@@ -0,0 +1,10 @@
+package dto
+
+type PolicyOrderDto struct {
+	ID    string  `json:"id"`
+	Order float64 `json:"order"`
+}
+
+type PolicyChangeOrderDto struct {
+	TargetIndex int `json:"targetIndex"`
+}
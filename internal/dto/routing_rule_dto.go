@@ -11,7 +11,7 @@ const (
 type RoutingRuleDto struct {
 	ID              string                `json:"id,omitempty"`
 	Name            string                `json:"name,omitempty"`
-	Order           int64                 `json:"order,omitempty"`
+	Order           *int64                `json:"order,omitempty"`
 	IsDefault       bool                  `json:"isDefault,omitempty"`
 	Timezone        string                `json:"timezone,omitempty"`
 	Criteria        *CriteriaDto          `json:"criteria,omitempty"`
@@ -32,3 +32,7 @@ type RoutingRuleNotifyDto struct {
 	Type string `json:"type"`
 	ID   string `json:"id"`
 }
+
+type RoutingRuleChangeOrderDto struct {
+	Order int `json:"order"`
+}
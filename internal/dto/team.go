@@ -25,6 +25,15 @@ type (
 	}
 	TeamMember struct {
 		AccountId string `json:"accountId"`
+		// Role is the JSM Operations role of the member. It is managed through the JSM Ops API, not the Teams API.
+		Role string `json:"-"`
+	}
+	TeamMemberRoleDto struct {
+		AccountId string `json:"accountId"`
+		Role      string `json:"role"`
+	}
+	TeamMemberRoleUpdate struct {
+		Role string `json:"role"`
 	}
 	TeamMemberList struct {
 		Members []TeamMember `json:"members"`
@@ -35,3 +44,8 @@ type (
 		InviteUsernames []string `json:"inviteUsernames"`
 	}
 )
+
+type TeamListResponse struct {
+	Entities []TeamDto `json:"entities"`
+	Cursor   string    `json:"cursor"`
+}
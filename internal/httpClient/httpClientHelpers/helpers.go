@@ -23,6 +23,18 @@ func GenerateJsmOpsClientRequest(providerModel dto.AtlassianOpsProviderModel) *h
 	return req
 }
 
+// GenerateJsmOpsIntegrationClientRequest creates a request for the JSM Ops integration API, which is authenticated
+// with the API key of an integration instead of the user credentials.
+func GenerateJsmOpsIntegrationClientRequest(providerModel dto.AtlassianOpsProviderModel, apiKey string) *httpClient.Request {
+	req := httpClient.NewRequest()
+	req.SetUrl(fmt.Sprintf("%s/jsm/ops/integration", getAtlassianApiDomain(providerModel.GetIsStaging())))
+	req.SetRetryCount(providerModel.GetApiRetryCount())
+	req.SetRetryWaitTime(providerModel.GetApiRetryWait())
+	req.SetRetryMaxWaitTime(providerModel.GetApiRetryWaitMax())
+	req.SetHeader("Authorization", fmt.Sprintf("GenieKey %s", apiKey))
+	return req
+}
+
 func GenerateTeamsClientRequest(providerModel dto.AtlassianOpsProviderModel) *httpClient.Request {
 	req := httpClient.NewRequest()
 	req.SetUrl(fmt.Sprintf("https://%s/gateway/api/public/teams/v1/org/", providerModel.GetDomainName()))
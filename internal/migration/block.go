@@ -0,0 +1,160 @@
+package migration
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// block is an opsgenie resource, or one of its nested blocks. The attributes and blocks read by the converters are
+// recorded, so that the fields which have no equivalent can be reported.
+type block struct {
+	attributes map[string]hclwrite.Tokens
+	blocks     map[string][]*block
+	used       map[string]bool
+}
+
+func newBlock() *block {
+	return &block{
+		attributes: make(map[string]hclwrite.Tokens),
+		blocks:     make(map[string][]*block),
+		used:       make(map[string]bool),
+	}
+}
+
+// attribute returns the expression of an attribute, if it is set.
+func (b *block) attribute(name string) (hclwrite.Tokens, bool) {
+	b.used[name] = true
+	tokens, ok := b.attributes[name]
+	return tokens, ok
+}
+
+// nested returns the nested blocks with the given type.
+func (b *block) nested(name string) []*block {
+	b.used[name] = true
+	return b.blocks[name]
+}
+
+// first returns the first nested block with the given type, or nil if there is none. The fields of the other blocks
+// are reported as having no equivalent.
+func (b *block) first(name string) *block {
+	nested := b.nested(name)
+	if len(nested) == 0 {
+		return nil
+	}
+	return nested[0]
+}
+
+// unused returns the paths of the attributes and nested blocks which were not read by the converter.
+func (b *block) unused(prefix string) []string {
+	seen := make(map[string]bool)
+	var paths []string
+
+	add := func(path string) {
+		if !seen[path] {
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+
+	for name := range b.attributes {
+		if !b.used[name] {
+			add(prefix + name)
+		}
+	}
+	for name, nested := range b.blocks {
+		if !b.used[name] {
+			add(prefix + name)
+			continue
+		}
+		for _, n := range nested {
+			for _, path := range n.unused(prefix + name + ".") {
+				add(path)
+			}
+		}
+	}
+
+	sort.Strings(paths)
+	return paths
+}
+
+// object is an object of the converted configuration, keeping its attributes in the order they are set.
+type object struct {
+	names  []string
+	values map[string]hclwrite.Tokens
+}
+
+func newObject() *object {
+	return &object{values: make(map[string]hclwrite.Tokens)}
+}
+
+func (o *object) set(name string, tokens hclwrite.Tokens) {
+	if _, ok := o.values[name]; !ok {
+		o.names = append(o.names, name)
+	}
+	o.values[name] = tokens
+}
+
+// copy sets the attributes of the source block which have the same name in the converted object.
+func (o *object) copy(src *block, names ...string) {
+	for _, name := range names {
+		o.rename(src, name, name)
+	}
+}
+
+// rename sets an attribute of the source block under another name in the converted object.
+func (o *object) rename(src *block, from string, to string) {
+	if tokens, ok := src.attribute(from); ok {
+		o.set(to, tokens)
+	}
+}
+
+// setObject sets a nested object, unless it is empty.
+func (o *object) setObject(name string, nested *object) {
+	if nested != nil && len(nested.names) > 0 {
+		o.set(name, nested.tokens())
+	}
+}
+
+// setList sets a list of nested objects, unless it is empty.
+func (o *object) setList(name string, nested []*object) {
+	if len(nested) == 0 {
+		return
+	}
+
+	elements := make([]hclwrite.Tokens, 0, len(nested))
+	for _, n := range nested {
+		elements = append(elements, n.tokens())
+	}
+	o.set(name, hclwrite.TokensForTuple(elements))
+}
+
+func (o *object) tokens() hclwrite.Tokens {
+	attributes := make([]hclwrite.ObjectAttrTokens, 0, len(o.names))
+	for _, name := range o.names {
+		attributes = append(attributes, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(name),
+			Value: o.values[name],
+		})
+	}
+	return hclwrite.TokensForObject(attributes)
+}
+
+// negate returns the negation of a boolean expression.
+func negate(tokens hclwrite.Tokens) hclwrite.Tokens {
+	switch strings.TrimSpace(string(tokens.Bytes())) {
+	case "true":
+		return hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte("false")}}
+	case "false":
+		return hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte("true")}}
+	}
+
+	negated := hclwrite.Tokens{
+		{Type: hclsyntax.TokenBang, Bytes: []byte("!")},
+		{Type: hclsyntax.TokenOParen, Bytes: []byte("(")},
+	}
+	negated = append(negated, tokens...)
+	return append(negated, &hclwrite.Token{Type: hclsyntax.TokenCParen, Bytes: []byte(")")})
+}
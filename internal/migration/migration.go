@@ -0,0 +1,383 @@
+// Package migration converts the Terraform configuration and state of the Opsgenie provider into configuration of the
+// atlassian-operations provider.
+package migration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// metaArguments are the resource arguments handled by Terraform itself, which are copied as they are.
+var metaArguments = []string{"count", "for_each", "depends_on"}
+
+// migration holds the converted configuration along with the report of what could not be converted.
+type migration struct {
+	file      *hclwrite.File
+	variables map[string]string
+	report    []string
+}
+
+// Migrate reads an Opsgenie configuration file, or a state file when the file is a JSON document, writes the matching
+// atlassian-operations configuration to w, and the list of the fields and resources which have no equivalent to report.
+func Migrate(filename string, w io.Writer, report io.Writer) error {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	m := &migration{
+		file:      hclwrite.NewEmptyFile(),
+		variables: make(map[string]string),
+	}
+
+	if json.Valid(src) {
+		err = m.migrateState(src)
+	} else {
+		err = m.migrateConfiguration(src, filename)
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := m.output().WriteTo(w); err != nil {
+		return err
+	}
+
+	for _, line := range m.report {
+		if _, err := fmt.Fprintln(report, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateConfiguration converts the opsgenie resources of a configuration file. The other blocks are copied as they
+// are, apart from the opsgenie provider and data sources.
+func (m *migration) migrateConfiguration(src []byte, filename string) error {
+	file, diags := hclwrite.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	body := m.file.Body()
+	for _, b := range file.Body().Blocks() {
+		labels := b.Labels()
+
+		switch {
+		case b.Type() == "resource" && len(labels) == 2 && strings.HasPrefix(labels[0], "opsgenie_"):
+			m.migrateResource(labels[0], labels[1], m.parseBlock(b.Body(), true), b.Body())
+		case b.Type() == "provider" && len(labels) == 1 && labels[0] == "opsgenie":
+			m.addReport("provider.opsgenie", "the provider configuration is not converted, configure the atlassian-operations provider instead")
+		case b.Type() == "data" && len(labels) == 2 && strings.HasPrefix(labels[0], "opsgenie_"):
+			m.addReport(fmt.Sprintf("data.%s.%s", labels[0], labels[1]), "data sources are not converted")
+		default:
+			for name, attribute := range b.Body().Attributes() {
+				b.Body().SetAttributeRaw(name, m.rewriteReferences(attribute.Expr().BuildTokens(nil)))
+			}
+			body.AppendBlock(b)
+			body.AppendNewline()
+		}
+	}
+	return nil
+}
+
+// migrateState converts the opsgenie resources of a state file, one resource per instance.
+func (m *migration) migrateState(src []byte) error {
+	var state struct {
+		Resources []struct {
+			Mode      string `json:"mode"`
+			Type      string `json:"type"`
+			Name      string `json:"name"`
+			Instances []struct {
+				IndexKey   interface{}            `json:"index_key"`
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"instances"`
+		} `json:"resources"`
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(src))
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		return err
+	}
+
+	for _, r := range state.Resources {
+		if r.Mode != "managed" || !strings.HasPrefix(r.Type, "opsgenie_") {
+			continue
+		}
+
+		for _, instance := range r.Instances {
+			name := r.Name
+			if instance.IndexKey != nil {
+				name = fmt.Sprintf("%s_%v", r.Name, instance.IndexKey)
+			}
+
+			src := stateBlock(instance.Attributes)
+			// The ID is computed by the API, the converted resource gets a new one
+			src.attribute("id")
+			m.migrateResource(r.Type, name, src, nil)
+		}
+	}
+	return nil
+}
+
+// migrateResource converts an opsgenie resource with the converter of its type. body is the original resource block,
+// whose meta-arguments are copied, or nil when the resource is read from a state file.
+func (m *migration) migrateResource(sourceType string, name string, src *block, body *hclwrite.Body) {
+	address := fmt.Sprintf("%s.%s", sourceType, name)
+
+	convert, ok := converters[sourceType]
+	if !ok {
+		if sourceType == "opsgenie_team" || sourceType == "opsgenie_user" {
+			m.addReport(address, "teams and users are not converted, they are migrated along with the Opsgenie account")
+		} else {
+			m.addReport(address, "the resource is not converted")
+		}
+		return
+	}
+
+	for _, converted := range convert(name, src) {
+		block := m.file.Body().AppendNewBlock("resource", []string{converted.typeName, converted.name})
+		if body != nil {
+			for _, argument := range metaArguments {
+				if attribute := body.GetAttribute(argument); attribute != nil {
+					block.Body().SetAttributeRaw(argument, m.rewriteReferences(attribute.Expr().BuildTokens(nil)))
+				}
+			}
+		}
+		for _, attributeName := range converted.body.names {
+			block.Body().SetAttributeRaw(attributeName, converted.body.values[attributeName])
+		}
+		m.file.Body().AppendNewline()
+	}
+
+	if body != nil {
+		for _, nested := range body.Blocks() {
+			if nested.Type() == "lifecycle" {
+				m.addReport(address, "the lifecycle block is not converted")
+			}
+		}
+		m.reportDynamicBlocks(address, body, "")
+		if body.GetAttribute("provider") != nil {
+			m.addReport(address, "the provider argument is not converted")
+		}
+	}
+
+	for _, field := range src.unused("") {
+		m.addReport(address, fmt.Sprintf("%s has no equivalent", field))
+	}
+}
+
+// reportDynamicBlocks reports the dynamic blocks of a resource, at any nesting level.
+func (m *migration) reportDynamicBlocks(address string, body *hclwrite.Body, prefix string) {
+	for _, nested := range body.Blocks() {
+		if nested.Type() == "dynamic" {
+			m.addReport(address, fmt.Sprintf("the dynamic block %s%s is not converted", prefix, strings.Join(nested.Labels(), ".")))
+			continue
+		}
+		if nested.Type() != "lifecycle" {
+			m.reportDynamicBlocks(address, nested.Body(), prefix+nested.Type()+".")
+		}
+	}
+}
+
+// parseBlock reads the attributes and nested blocks of a resource, rewriting the references they hold. The
+// meta-arguments and the lifecycle block are only skipped in the resource body itself, as nested blocks may have
+// fields with the same names, such as the count of the repeat block of escalations.
+func (m *migration) parseBlock(body *hclwrite.Body, resource bool) *block {
+	b := newBlock()
+	for name, attribute := range body.Attributes() {
+		if resource && isMetaArgument(name) {
+			continue
+		}
+		b.attributes[name] = m.rewriteReferences(attribute.Expr().BuildTokens(nil))
+	}
+	for _, nested := range body.Blocks() {
+		if nested.Type() == "dynamic" || (resource && nested.Type() == "lifecycle") {
+			continue
+		}
+		b.blocks[nested.Type()] = append(b.blocks[nested.Type()], m.parseBlock(nested.Body(), false))
+	}
+	return b
+}
+
+// stateBlock reads the attributes of a resource instance of a state file. Lists of objects are the nested blocks of
+// the resource.
+func stateBlock(attributes map[string]interface{}) *block {
+	b := newBlock()
+	for name, value := range attributes {
+		if elements, ok := value.([]interface{}); ok && len(elements) > 0 {
+			if _, isObject := elements[0].(map[string]interface{}); isObject {
+				for _, element := range elements {
+					if object, ok := element.(map[string]interface{}); ok {
+						b.blocks[name] = append(b.blocks[name], stateBlock(object))
+					}
+				}
+				continue
+			}
+		}
+
+		if isEmpty(value) {
+			continue
+		}
+		b.attributes[name] = hclwrite.TokensForValue(jsonValue(value))
+	}
+	return b
+}
+
+// isEmpty tells whether a state value is null or an empty string, list or map, which are left out of the configuration.
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// jsonValue converts a value of a state file.
+func jsonValue(value interface{}) cty.Value {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	case json.Number:
+		if n, err := cty.ParseNumberVal(v.String()); err == nil {
+			return n
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			return cty.EmptyTupleVal
+		}
+		elements := make([]cty.Value, 0, len(v))
+		for _, element := range v {
+			elements = append(elements, jsonValue(element))
+		}
+		return cty.TupleVal(elements)
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return cty.EmptyObjectVal
+		}
+		attributes := make(map[string]cty.Value, len(v))
+		for key, element := range v {
+			attributes[key] = jsonValue(element)
+		}
+		return cty.ObjectVal(attributes)
+	}
+	return cty.NullVal(cty.DynamicPseudoType)
+}
+
+// rewriteReferences replaces the references to converted opsgenie resources with references to the matching
+// atlassian-operations resources. Teams and users are created by the Opsgenie migration, so the references to them
+// are replaced with variables holding the IDs of the migrated teams and users.
+func (m *migration) rewriteReferences(tokens hclwrite.Tokens) hclwrite.Tokens {
+	result := make(hclwrite.Tokens, 0, len(tokens))
+
+	for i := 0; i < len(tokens); i++ {
+		if i > 0 && tokens[i-1].Type == hclsyntax.TokenDot {
+			result = append(result, tokens[i])
+			continue
+		}
+
+		offset := 0
+		if isIdent(tokens, i, "data") && isDot(tokens, i+1) {
+			offset = 2
+		}
+
+		sourceType := identAt(tokens, i+offset)
+		name := identAt(tokens, i+offset+2)
+		if sourceType == "" || name == "" || !isDot(tokens, i+offset+1) {
+			result = append(result, tokens[i])
+			continue
+		}
+
+		if (sourceType == "opsgenie_team" || sourceType == "opsgenie_user") && isDot(tokens, i+offset+3) && isIdent(tokens, i+offset+4, "id") {
+			variable := fmt.Sprintf("%s_%s_id", sourceType, name)
+			kind := strings.TrimPrefix(sourceType, "opsgenie_")
+			m.variables[variable] = fmt.Sprintf("The ID of the %s migrated from %s.%s", map[string]string{"team": "team", "user": "user account"}[kind], sourceType, name)
+			result = append(result, hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: "var"},
+				hcl.TraverseAttr{Name: variable},
+			})...)
+			i += offset + 4
+			continue
+		}
+
+		if targetType, ok := referenceTypes[sourceType]; ok && offset == 0 {
+			result = append(result, &hclwrite.Token{Type: hclsyntax.TokenIdent, Bytes: []byte(targetType)})
+			continue
+		}
+
+		result = append(result, tokens[i])
+	}
+
+	return result
+}
+
+// output returns the converted configuration, preceded by the variables holding the IDs of the migrated teams and
+// users.
+func (m *migration) output() *hclwrite.File {
+	if len(m.variables) == 0 {
+		return m.file
+	}
+
+	names := make([]string, 0, len(m.variables))
+	for name := range m.variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	file := hclwrite.NewEmptyFile()
+	for _, name := range names {
+		variable := file.Body().AppendNewBlock("variable", []string{name})
+		variable.Body().SetAttributeValue("description", cty.StringVal(m.variables[name]))
+		variable.Body().SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+		file.Body().AppendNewline()
+	}
+	file.Body().AppendUnstructuredTokens(m.file.BuildTokens(nil))
+	return file
+}
+
+func (m *migration) addReport(address string, message string) {
+	m.report = append(m.report, fmt.Sprintf("%s: %s", address, message))
+}
+
+func isMetaArgument(name string) bool {
+	for _, argument := range metaArguments {
+		if name == argument || name == "provider" {
+			return true
+		}
+	}
+	return false
+}
+
+func identAt(tokens hclwrite.Tokens, i int) string {
+	if i >= len(tokens) || tokens[i].Type != hclsyntax.TokenIdent {
+		return ""
+	}
+	return string(tokens[i].Bytes)
+}
+
+func isIdent(tokens hclwrite.Tokens, i int, name string) bool {
+	return identAt(tokens, i) == name
+}
+
+func isDot(tokens hclwrite.Tokens, i int) bool {
+	return i < len(tokens) && tokens[i].Type == hclsyntax.TokenDot
+}
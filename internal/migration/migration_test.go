@@ -0,0 +1,260 @@
+package migration
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMigrate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filename string
+		input    string
+		output   string
+		report   string
+	}{
+		{
+			name:     "escalation configuration",
+			filename: "main.tf",
+			input: `
+resource "opsgenie_escalation" "example" {
+  count         = 2
+  name          = "escalation-${count.index}"
+  owner_team_id = opsgenie_team.ops.id
+
+  rules {
+    condition   = "if-not-acked"
+    notify_type = "default"
+    delay       = 1
+
+    recipient {
+      type = "schedule"
+      id   = opsgenie_schedule.primary.id
+    }
+  }
+
+  repeat {
+    wait_interval = 10
+    count         = 1
+  }
+
+  lifecycle {
+    ignore_changes = [name]
+  }
+}
+`,
+			output: `variable "opsgenie_team_ops_id" {
+  description = "The ID of the team migrated from opsgenie_team.ops"
+  type        = string
+}
+
+resource "atlassian-operations_escalation" "example" {
+  count   = 2
+  name    = "escalation-${count.index}"
+  team_id = var.opsgenie_team_ops_id
+  rules = [{
+    condition   = "if-not-acked"
+    notify_type = "default"
+    delay       = 1
+    recipient = {
+      type = "schedule"
+      id   = atlassian-operations_schedule.primary.id
+    }
+  }]
+  repeat = {
+    wait_interval = 10
+    count         = 1
+  }
+}
+
+`,
+			report: `opsgenie_escalation.example: the lifecycle block is not converted
+`,
+		},
+		{
+			name:     "notification policy configuration",
+			filename: "main.tf",
+			input: `
+resource "opsgenie_notification_policy" "example" {
+  name    = "policy"
+  team_id = "c2a9f9b4-3f3e-4a7e-9a6b-0d2b1c0f6e1a"
+
+  de_duplication_action {
+    de_duplication_action_type = "frequency-based"
+    count                      = 2
+
+    duration {
+      time_amount = 5
+      time_unit   = "minutes"
+    }
+  }
+
+  dynamic "auto_close_action" {
+    for_each = var.auto_close
+    content {
+      duration {
+        time_amount = auto_close_action.value
+      }
+    }
+  }
+}
+`,
+			output: `resource "atlassian-operations_notification_policy" "example" {
+  name    = "policy"
+  team_id = "c2a9f9b4-3f3e-4a7e-9a6b-0d2b1c0f6e1a"
+  deduplication_action = {
+    deduplication_action_type = "frequency-based"
+    count_value_limit         = 2
+    wait_duration             = 5
+    duration_format           = "minutes"
+  }
+}
+
+`,
+			report: `opsgenie_notification_policy.example: the dynamic block auto_close_action is not converted
+`,
+		},
+		{
+			name:     "unconverted blocks",
+			filename: "main.tf",
+			input: `
+provider "opsgenie" {
+  api_key = var.opsgenie_api_key
+}
+
+data "opsgenie_user" "me" {
+  username = "me@example.com"
+}
+
+resource "opsgenie_team" "ops" {
+  name = "ops"
+}
+
+resource "opsgenie_custom_role" "role" {
+  role_name = "role"
+}
+
+resource "opsgenie_heartbeat" "example" {
+  name          = "heartbeat"
+  owner_team_id = "c2a9f9b4-3f3e-4a7e-9a6b-0d2b1c0f6e1a"
+  interval      = 10
+  interval_unit = "minutes"
+  enabled       = true
+  alert_message = "Heartbeat expired"
+}
+
+output "heartbeat" {
+  value = opsgenie_heartbeat.example.name
+}
+`,
+			output: `resource "atlassian-operations_heartbeat" "example" {
+  name          = "heartbeat"
+  interval      = 10
+  interval_unit = "minutes"
+  enabled       = true
+  alert_message = "Heartbeat expired"
+  team_id       = "c2a9f9b4-3f3e-4a7e-9a6b-0d2b1c0f6e1a"
+}
+
+output "heartbeat" {
+  value = atlassian-operations_heartbeat.example.name
+}
+
+`,
+			report: `provider.opsgenie: the provider configuration is not converted, configure the atlassian-operations provider instead
+data.opsgenie_user.me: data sources are not converted
+opsgenie_team.ops: teams and users are not converted, they are migrated along with the Opsgenie account
+opsgenie_custom_role.role: the resource is not converted
+`,
+		},
+		{
+			name:     "escalation state",
+			filename: "terraform.tfstate",
+			input: `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "opsgenie_escalation",
+      "name": "example",
+      "instances": [
+        {
+          "index_key": 0,
+          "attributes": {
+            "id": "4513b7ea-3b91-438f-b7e4-e3e54af9147c",
+            "name": "escalation",
+            "description": "",
+            "owner_team_id": "c2a9f9b4-3f3e-4a7e-9a6b-0d2b1c0f6e1a",
+            "rules": [
+              {
+                "condition": "if-not-acked",
+                "notify_type": "default",
+                "delay": 1,
+                "recipient": [{"type": "user", "id": "0e7a5f21-9d55-4c3c-8b1e-2a3b4c5d6e7f"}]
+              }
+            ],
+            "repeat": [
+              {"wait_interval": 10, "count": 1, "reset_recipient_states": false, "close_alert_after_all": false}
+            ],
+            "unknown_field": "value"
+          }
+        }
+      ]
+    },
+    {
+      "mode": "data",
+      "type": "opsgenie_user",
+      "name": "me",
+      "instances": []
+    }
+  ]
+}`,
+			output: `resource "atlassian-operations_escalation" "example_0" {
+  name    = "escalation"
+  team_id = "c2a9f9b4-3f3e-4a7e-9a6b-0d2b1c0f6e1a"
+  rules = [{
+    condition   = "if-not-acked"
+    notify_type = "default"
+    delay       = 1
+    recipient = {
+      type = "user"
+      id   = "0e7a5f21-9d55-4c3c-8b1e-2a3b4c5d6e7f"
+    }
+  }]
+  repeat = {
+    wait_interval          = 10
+    count                  = 1
+    reset_recipient_states = false
+    close_alert_after_all  = false
+  }
+}
+
+`,
+			report: `opsgenie_escalation.example_0: unknown_field has no equivalent
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), testCase.filename)
+			if err := os.WriteFile(filename, []byte(testCase.input), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			var output, report bytes.Buffer
+			if err := Migrate(filename, &output, &report); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if output.String() != testCase.output {
+				t.Errorf("unexpected output, expected:\n%s\ngot:\n%s", testCase.output, output.String())
+			}
+			if report.String() != testCase.report {
+				t.Errorf("unexpected report, expected:\n%s\ngot:\n%s", testCase.report, report.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package migration
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// convertedResource is a resource of the converted configuration.
+type convertedResource struct {
+	typeName string
+	name     string
+	body     *object
+}
+
+// converter converts an opsgenie resource into one or more atlassian-operations resources.
+type converter func(name string, src *block) []convertedResource
+
+var converters = map[string]converter{
+	"opsgenie_schedule":            single("atlassian-operations_schedule", convertSchedule),
+	"opsgenie_schedule_rotation":   single("atlassian-operations_schedule_rotation", convertScheduleRotation),
+	"opsgenie_escalation":          single("atlassian-operations_escalation", convertEscalation),
+	"opsgenie_heartbeat":           single("atlassian-operations_heartbeat", convertHeartbeat),
+	"opsgenie_notification_rule":   single("atlassian-operations_notification_rule", convertNotificationRule),
+	"opsgenie_alert_policy":        single("atlassian-operations_alert_policy", convertAlertPolicy),
+	"opsgenie_notification_policy": single("atlassian-operations_notification_policy", convertNotificationPolicy),
+	"opsgenie_api_integration":     single("atlassian-operations_api_integration", convertApiIntegration),
+	"opsgenie_email_integration":   single("atlassian-operations_email_integration", convertEmailIntegration),
+	"opsgenie_integration_action":  convertIntegrationAction,
+}
+
+// referenceTypes maps the opsgenie resources converted into a single resource to the type of that resource, so that
+// references to them can be rewritten.
+var referenceTypes = map[string]string{
+	"opsgenie_schedule":            "atlassian-operations_schedule",
+	"opsgenie_schedule_rotation":   "atlassian-operations_schedule_rotation",
+	"opsgenie_escalation":          "atlassian-operations_escalation",
+	"opsgenie_heartbeat":           "atlassian-operations_heartbeat",
+	"opsgenie_notification_rule":   "atlassian-operations_notification_rule",
+	"opsgenie_alert_policy":        "atlassian-operations_alert_policy",
+	"opsgenie_notification_policy": "atlassian-operations_notification_policy",
+	"opsgenie_api_integration":     "atlassian-operations_api_integration",
+	"opsgenie_email_integration":   "atlassian-operations_email_integration",
+}
+
+// integrationActionTypes maps the action blocks of opsgenie_integration_action to the matching action types.
+var integrationActionTypes = []struct {
+	block      string
+	actionType string
+}{
+	{"create", "create"},
+	{"close", "close"},
+	{"acknowledge", "acknowledge"},
+	{"add_note", "addNote"},
+	{"ignore", "ignore"},
+}
+
+// integrationActionFields maps the alert fields of the opsgenie integration actions to the keys of field_mappings.
+var integrationActionFields = []struct {
+	attribute string
+	key       string
+}{
+	{"message", "message"},
+	{"alias", "alias"},
+	{"description", "description"},
+	{"entity", "entity"},
+	{"source", "source"},
+	{"user", "user"},
+	{"note", "note"},
+	{"tags", "tags"},
+	{"custom_priority", "priority"},
+}
+
+func single(typeName string, convert func(src *block, dst *object)) converter {
+	return func(name string, src *block) []convertedResource {
+		dst := newObject()
+		convert(src, dst)
+		return []convertedResource{{typeName: typeName, name: name, body: dst}}
+	}
+}
+
+func convertSchedule(src *block, dst *object) {
+	dst.copy(src, "name", "description", "timezone", "enabled")
+	dst.rename(src, "owner_team_id", "team_id")
+}
+
+func convertScheduleRotation(src *block, dst *object) {
+	dst.copy(src, "schedule_id", "name", "start_date", "end_date", "type", "length")
+
+	var participants []*object
+	for _, participant := range src.nested("participant") {
+		converted := newObject()
+		converted.copy(participant, "type", "id")
+		participants = append(participants, converted)
+	}
+	dst.setList("participants", participants)
+
+	if timeRestriction := src.first("time_restriction"); timeRestriction != nil {
+		dst.setObject("time_restriction", convertTimeRestriction(timeRestriction))
+	}
+}
+
+func convertEscalation(src *block, dst *object) {
+	dst.copy(src, "name", "description")
+	dst.rename(src, "owner_team_id", "team_id")
+
+	var rules []*object
+	for _, rule := range src.nested("rules") {
+		converted := newObject()
+		converted.copy(rule, "condition", "notify_type", "delay")
+		if recipient := rule.first("recipient"); recipient != nil {
+			convertedRecipient := newObject()
+			convertedRecipient.copy(recipient, "type", "id")
+			converted.setObject("recipient", convertedRecipient)
+		}
+		rules = append(rules, converted)
+	}
+	dst.setList("rules", rules)
+
+	if repeat := src.first("repeat"); repeat != nil {
+		converted := newObject()
+		converted.copy(repeat, "wait_interval", "count", "reset_recipient_states", "close_alert_after_all")
+		dst.setObject("repeat", converted)
+	}
+}
+
+func convertHeartbeat(src *block, dst *object) {
+	dst.copy(src, "name", "description", "interval", "interval_unit", "enabled", "alert_message", "alert_tags", "alert_priority")
+	dst.rename(src, "owner_team_id", "team_id")
+}
+
+func convertNotificationRule(src *block, dst *object) {
+	dst.copy(src, "name", "action_type", "notification_time", "order", "enabled")
+
+	if criteria := src.first("criteria"); criteria != nil {
+		dst.setObject("criteria", convertFilter(criteria))
+	}
+
+	if timeRestriction := src.first("time_restriction"); timeRestriction != nil {
+		dst.setObject("time_restriction", convertTimeRestriction(timeRestriction))
+	}
+
+	var steps []*object
+	for _, step := range src.nested("steps") {
+		converted := newObject()
+		converted.copy(step, "send_after", "enabled")
+		if contact := step.first("contact"); contact != nil {
+			convertedContact := newObject()
+			convertedContact.copy(contact, "method", "to")
+			converted.setObject("contact", convertedContact)
+		}
+		steps = append(steps, converted)
+	}
+	dst.setList("steps", steps)
+
+	if repeat := src.first("repeat"); repeat != nil {
+		converted := newObject()
+		converted.copy(repeat, "loop_after", "enabled")
+		dst.setObject("repeat", converted)
+	}
+}
+
+func convertAlertPolicy(src *block, dst *object) {
+	dst.copy(src, "name", "team_id", "enabled", "message", "alias", "alert_description", "entity", "source", "actions", "tags", "details")
+	dst.rename(src, "policy_description", "description")
+	dst.rename(src, "continue_policy", "continue")
+
+	if priority, ok := src.attribute("priority"); ok {
+		dst.set("update_priority", hclwrite.TokensForValue(cty.True))
+		dst.set("priority_value", priority)
+	}
+
+	for _, field := range []string{"responders", "details", "actions", "tags"} {
+		if ignore, ok := src.attribute("ignore_original_" + field); ok {
+			dst.set("keep_original_"+field, negate(ignore))
+		}
+	}
+
+	if filter := src.first("filter"); filter != nil {
+		dst.setObject("filter", convertFilter(filter))
+	}
+
+	var responders []*object
+	for _, responder := range src.nested("responders") {
+		converted := newObject()
+		converted.copy(responder, "type", "id")
+		responders = append(responders, converted)
+	}
+	dst.setList("responders", responders)
+}
+
+func convertNotificationPolicy(src *block, dst *object) {
+	dst.copy(src, "name", "team_id", "enabled", "suppress")
+	dst.rename(src, "policy_description", "description")
+
+	if filter := src.first("filter"); filter != nil {
+		dst.setObject("filter", convertFilter(filter))
+	}
+
+	if action := src.first("auto_close_action"); action != nil {
+		converted := newObject()
+		convertDuration(action, converted)
+		dst.setObject("auto_close_action", converted)
+	}
+
+	if action := src.first("auto_restart_action"); action != nil {
+		converted := newObject()
+		convertDuration(action, converted)
+		converted.copy(action, "max_repeat_count")
+		dst.setObject("auto_restart_action", converted)
+	}
+
+	if action := src.first("de_duplication_action"); action != nil {
+		converted := newObject()
+		converted.rename(action, "de_duplication_action_type", "deduplication_action_type")
+		converted.rename(action, "count", "count_value_limit")
+		convertDuration(action, converted)
+		dst.setObject("deduplication_action", converted)
+	}
+
+	if action := src.first("delay_action"); action != nil {
+		converted := newObject()
+		converted.copy(action, "delay_option")
+		delayTime := newObject()
+		delayTime.rename(action, "until_hour", "hours")
+		delayTime.rename(action, "until_minute", "minutes")
+		converted.setObject("delay_time", delayTime)
+		convertDuration(action, converted)
+		dst.setObject("delay_action", converted)
+	}
+}
+
+func convertApiIntegration(src *block, dst *object) {
+	dst.copy(src, "name", "enabled")
+	if _, ok := src.attributes["type"]; ok {
+		dst.copy(src, "type")
+	} else {
+		// The type defaults to API in the Opsgenie provider
+		dst.set("type", hclwrite.TokensForValue(cty.StringVal("API")))
+	}
+	dst.rename(src, "owner_team_id", "team_id")
+
+	properties := newObject()
+	properties.rename(src, "suppress_notifications", "suppressNotifications")
+	if len(properties.names) > 0 {
+		dst.set("type_specific_properties", hclwrite.TokensForFunctionCall("jsonencode", properties.tokens()))
+	}
+}
+
+func convertEmailIntegration(src *block, dst *object) {
+	dst.copy(src, "name", "enabled")
+	dst.rename(src, "owner_team_id", "team_id")
+
+	properties := newObject()
+	properties.copy(src, "email_username", "suppress_notifications")
+	dst.setObject("type_specific_properties", properties)
+}
+
+// convertIntegrationAction converts each action block of an opsgenie_integration_action into its own
+// atlassian-operations_integration_action resource.
+func convertIntegrationAction(name string, src *block) []convertedResource {
+	integrationId, _ := src.attribute("integration_id")
+
+	var converted []convertedResource
+	for _, actionType := range integrationActionTypes {
+		for i, action := range src.nested(actionType.block) {
+			dst := newObject()
+			dst.set("integration_id", integrationId)
+			dst.set("type", hclwrite.TokensForValue(cty.StringVal(actionType.actionType)))
+			dst.copy(action, "name")
+			dst.set("domain", hclwrite.TokensForValue(cty.StringVal("alert")))
+			dst.set("direction", hclwrite.TokensForValue(cty.StringVal("incoming")))
+
+			if filter := action.first("filter"); filter != nil {
+				converted := newObject()
+				converted.rename(filter, "type", "condition_match_type")
+				conditions := convertConditions(filter.nested("conditions"))
+				converted.set("conditions_empty", hclwrite.TokensForValue(cty.BoolVal(len(conditions) == 0)))
+				converted.setList("conditions", conditions)
+				dst.setObject("filter", converted)
+			}
+
+			fieldMappings := newObject()
+			for _, field := range integrationActionFields {
+				fieldMappings.rename(action, field.attribute, field.key)
+			}
+			if len(fieldMappings.names) > 0 {
+				dst.set("field_mappings", hclwrite.TokensForFunctionCall("jsonencode", fieldMappings.tokens()))
+			}
+
+			converted = append(converted, convertedResource{
+				typeName: "atlassian-operations_integration_action",
+				name:     fmt.Sprintf("%s_%s_%d", name, actionType.block, i),
+				body:     dst,
+			})
+		}
+	}
+	return converted
+}
+
+// convertTimeRestriction converts the time_restriction blocks of rotations and notification rules, which share the
+// shape of the time_restriction attributes.
+func convertTimeRestriction(src *block) *object {
+	dst := newObject()
+	dst.copy(src, "type")
+
+	if restriction := src.first("restriction"); restriction != nil {
+		converted := newObject()
+		converted.copy(restriction, "start_hour", "start_min", "end_hour", "end_min")
+		dst.setObject("restriction", converted)
+	}
+
+	var restrictions []*object
+	for _, restriction := range src.nested("restrictions") {
+		converted := newObject()
+		converted.copy(restriction, "start_day", "end_day", "start_hour", "start_min", "end_hour", "end_min")
+		restrictions = append(restrictions, converted)
+	}
+	dst.setList("restrictions", restrictions)
+
+	return dst
+}
+
+// convertFilter converts the criteria of notification rules and the filters of policies.
+func convertFilter(src *block) *object {
+	dst := newObject()
+	dst.copy(src, "type")
+	dst.setList("conditions", convertConditions(src.nested("conditions")))
+	return dst
+}
+
+func convertConditions(src []*block) []*object {
+	var conditions []*object
+	for _, condition := range src {
+		converted := newObject()
+		converted.copy(condition, "field", "operation", "key", "not", "expected_value", "order")
+		conditions = append(conditions, converted)
+	}
+	return conditions
+}
+
+// convertDuration converts the duration block of the notification policy actions into their wait_duration and
+// duration_format attributes.
+func convertDuration(src *block, dst *object) {
+	if duration := src.first("duration"); duration != nil {
+		dst.rename(duration, "time_amount", "wait_duration")
+		dst.rename(duration, "time_unit", "duration_format")
+	}
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &AlertPolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &AlertPolicyDataSource{}
+)
+
+func NewAlertPolicyDataSource() datasource.DataSource {
+	return &AlertPolicyDataSource{}
+}
+
+// AlertPolicyDataSource defines the data source implementation.
+type AlertPolicyDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *AlertPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_policy"
+}
+
+func (d *AlertPolicyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Alert policy data source",
+		Attributes:          schemaAttributes.AlertPolicyDataSourceAttributes,
+	}
+}
+
+func (d *AlertPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring alert_policy_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure alert_policy_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured alert_policy_data_source")
+}
+
+func (d *AlertPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.AlertPolicyModel
+
+	tflog.Trace(ctx, "Reading alert policy data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read alert policy configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	var baseUrl string
+	if model.TeamID.IsNull() {
+		baseUrl = "/v1/alerts/policies"
+	} else {
+		baseUrl = fmt.Sprintf("/v1/teams/%s/policies", model.TeamID.ValueString())
+	}
+
+	alertPolicyId := model.ID.ValueString()
+
+	if model.ID.IsNull() {
+		tflog.Trace(ctx, "Looking up the alert policy by name")
+
+		alertPolicies, err := fetchAllPages[dto.AlertPolicyDto](d.clientConfiguration, baseUrl, map[string]string{"type": "alert"})
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to list alert policies, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list alert policies, got error: %s", err))
+			return
+		}
+
+		var candidates []string
+		for _, alertPolicy := range alertPolicies {
+			if alertPolicy.Name == model.Name.ValueString() {
+				candidates = append(candidates, alertPolicy.ID)
+			}
+		}
+
+		if len(candidates) == 0 {
+			tflog.Error(ctx, "No alert policies found")
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("No alert policy named '%s' found", model.Name.ValueString()))
+			return
+		} else if len(candidates) > 1 {
+			tflog.Error(ctx, "Multiple alert policies found")
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Multiple alert policies named '%s' found: %s. Use id to select one of them.", model.Name.ValueString(), strings.Join(candidates, ", ")))
+			return
+		}
+
+		alertPolicyId = candidates[0]
+	}
+
+	tflog.Trace(ctx, "Sending HTTP request to JSM OPS API")
+
+	var alertPolicyDto dto.AlertPolicyDto
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(d.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s", baseUrl, alertPolicyId)).
+		Method(httpClient.GET).
+		SetBodyParseObject(&alertPolicyDto).
+		Send()
+
+	if httpResp == nil {
+		tflog.Error(ctx, "Client Error. Unable to read alert policy, got nil response")
+		resp.Diagnostics.AddError("Client Error", "Unable to read alert policy, got nil response")
+	} else if httpResp.IsError() {
+		statusCode := httpResp.GetStatusCode()
+		errorResponse := httpResp.GetErrorBody()
+		if errorResponse != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read alert policy, status code: %d. Got response: %s", statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert policy, status code: %d. Got response: %s", statusCode, *errorResponse))
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read alert policy, got http response: %d", statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert policy, got http response: %d", statusCode))
+		}
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read alert policy, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert policy or to parse received data, got error: %s", err))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP request to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
+	result, err := AlertPolicyDtoToModel(ctx, &alertPolicyDto)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse received alert policy, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Successfully read alert policy data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
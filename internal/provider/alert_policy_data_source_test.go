@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAlertPolicyDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	alertPolicyName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_alert_policy" "example" {
+  name        = "` + alertPolicyName + `"
+  description = "Test alert policy description"
+  team_id     = atlassian-operations_team.example.id
+  type        = "alert"
+  enabled     = true
+  message     = "Test alert message"
+  tags        = ["tag1"]
+}
+
+data "atlassian-operations_alert_policy" "by_name" {
+	depends_on = ["atlassian-operations_alert_policy.example"]
+	team_id = atlassian-operations_team.example.id
+	name = "` + alertPolicyName + `"
+}
+
+data "atlassian-operations_alert_policy" "by_id" {
+	team_id = atlassian-operations_team.example.id
+	id = atlassian-operations_alert_policy.example.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_name", "id", "atlassian-operations_alert_policy.example", "id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_name", "name", "atlassian-operations_alert_policy.example", "name"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_name", "team_id", "atlassian-operations_alert_policy.example", "team_id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_name", "type", "atlassian-operations_alert_policy.example", "type"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_name", "description", "atlassian-operations_alert_policy.example", "description"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_name", "enabled", "atlassian-operations_alert_policy.example", "enabled"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_name", "message", "atlassian-operations_alert_policy.example", "message"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_name", "tags.#", "atlassian-operations_alert_policy.example", "tags.#"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_id", "id", "atlassian-operations_alert_policy.example", "id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_id", "name", "atlassian-operations_alert_policy.example", "name"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_id", "team_id", "atlassian-operations_alert_policy.example", "team_id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_id", "type", "atlassian-operations_alert_policy.example", "type"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_id", "description", "atlassian-operations_alert_policy.example", "description"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_id", "enabled", "atlassian-operations_alert_policy.example", "enabled"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_id", "message", "atlassian-operations_alert_policy.example", "message"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policy.by_id", "tags.#", "atlassian-operations_alert_policy.example", "tags.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &AlertPolicyListResource{}
+var _ list.ListResourceWithConfigure = &AlertPolicyListResource{}
+
+func NewAlertPolicyListResource() list.ListResource {
+	return &AlertPolicyListResource{}
+}
+
+// AlertPolicyListResource lists the global alert policies, or the alert policies of a team.
+// The metadata, configuration and read logic are shared with AlertPolicyResource.
+type AlertPolicyListResource struct {
+	AlertPolicyResource
+}
+
+func (r *AlertPolicyListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.OptionallyTeamScopedListResourceAttributes,
+	}
+}
+
+func (r *AlertPolicyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data dataModels.TeamScopedListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var url string
+	if data.TeamId.IsNull() {
+		url = "/v1/alerts/policies"
+	} else {
+		url = fmt.Sprintf("/v1/teams/%s/policies", data.TeamId.ValueString())
+	}
+
+	tflog.Trace(ctx, "Listing alert policies")
+
+	alertPolicies, err := fetchAllPages[dto.AlertPolicyDto](r.clientConfiguration, url, map[string]string{"type": "alert"})
+	if err != nil {
+		stream.Results = listError(ctx, "Unable to list alert policies", err)
+		return
+	}
+
+	listed := make([]listedResource, 0, len(alertPolicies))
+	for _, item := range alertPolicies {
+		listed = append(listed, listedResource{
+			displayName: item.Name,
+			identity: map[string]types.String{
+				"id":      types.StringValue(item.ID),
+				"team_id": data.TeamId,
+			},
+		})
+	}
+
+	stream.Results = listResults(ctx, req, &r.AlertPolicyResource, listed)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,6 +21,7 @@ var (
 	_ resource.Resource                = &AlertPolicyResource{}
 	_ resource.ResourceWithConfigure   = &AlertPolicyResource{}
 	_ resource.ResourceWithImportState = &AlertPolicyResource{}
+	_ resource.ResourceWithIdentity    = &AlertPolicyResource{}
 )
 
 type AlertPolicyResource struct {
@@ -40,6 +42,12 @@ func (r *AlertPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *AlertPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.OptionallyTeamScopedIdentityAttributes,
+	}
+}
+
 func (r *AlertPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring AlertPolicyResource")
 
@@ -116,6 +124,7 @@ func (r *AlertPolicyResource) Create(ctx context.Context, req resource.CreateReq
 	// Update state with response
 	result, _ := AlertPolicyDtoToModel(ctx, alertPolicyDto)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *AlertPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -170,6 +179,7 @@ func (r *AlertPolicyResource) Read(ctx context.Context, req resource.ReadRequest
 
 	result, _ := AlertPolicyDtoToModel(ctx, &alertPolicyDto)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *AlertPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -225,6 +235,7 @@ func (r *AlertPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 
 	result, _ := AlertPolicyDtoToModel(ctx, alertPolicyDto)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *AlertPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -274,11 +285,43 @@ func (r *AlertPolicyResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *AlertPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
+	if team, name, found := splitNameImportId(req.ID); found {
+		// Imported by name, the team being given by ID or by name, or left empty for global policies
+		nameAndId := func(item dto.AlertPolicyDto) (string, string) {
+			return item.Name, item.ID
+		}
+
+		var teamId, id string
+		var err error
+		if team == "" {
+			id, err = lookupIdByName(r.clientConfiguration, "alert policy", "/v1/alerts/policies", map[string]string{"type": "alert"}, name, nameAndId)
+		} else {
+			teamId, id, err = resolveTeamScopedName(r.clientConfiguration, "alert policy", team, name, "/v1/teams/%s/policies", map[string]string{"type": "alert"}, nameAndId)
+		}
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Unable to import alert policy, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import alert policy, got error: %s", err))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		if teamId != "" {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamId)...)
+		}
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) > 2 || idParts[0] == "" || (len(idParts) == 2 && idParts[1] == "") {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: id,team_id (for team policies); or: id (for global policies). Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: id,team_id or team/name (for team policies, team being the ID or the name of the team); or: id or /name (for global policies). Got: %q", req.ID),
 		)
 		return
 	}
@@ -3,6 +3,7 @@ package provider
 import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -145,6 +146,18 @@ resource "atlassian-operations_alert_policy" "test" {
 						nil
 				},
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "atlassian-operations_alert_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_alert_policy.test"].Primary.Attributes["team_id"] +
+							"/" +
+							state.RootModule().Resources["atlassian-operations_alert_policy.test"].Primary.Attributes["name"],
+						nil
+				},
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
@@ -477,3 +490,106 @@ resource "atlassian-operations_alert_policy" "test" {
 		},
 	})
 }
+
+func TestAccAlertPolicyConditionsValidation(t *testing.T) {
+	alertPolicyName := uuid.NewString()
+	teamName := uuid.NewString()
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The expected_value of the conditions is checked against their operation at plan time
+			{
+				Config: providerConfig + testAccAlertPolicyConditionsConfig(alertPolicyName, teamName, emailPrimary, organizationId, `{
+        field          = "tags"
+        operation      = "is-empty"
+        expected_value = "critical"
+      }`),
+				ExpectError: regexp.MustCompile(`must\s+not\s+be\s+set\s+for\s+the\s+'is-empty'\s+operation`),
+			},
+			{
+				Config: providerConfig + testAccAlertPolicyConditionsConfig(alertPolicyName, teamName, emailPrimary, organizationId, `{
+        field     = "message"
+        operation = "contains"
+      }`),
+				ExpectError: regexp.MustCompile(`expected_value\s+must\s+be\s+set\s+for\s+the\s+'contains'\s+operation`),
+			},
+			// The fields of the alert policies are left to the API, and aren't checked against the fields of the rules
+			{
+				Config: providerConfig + testAccAlertPolicyConditionsConfig(alertPolicyName, teamName, emailPrimary, organizationId, `{
+        field          = "teams"
+        operation      = "contains"
+        expected_value = "`+teamName+`"
+      }`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Create with the order of the conditions defaulting to their index
+			{
+				Config: providerConfig + testAccAlertPolicyConditionsConfig(alertPolicyName, teamName, emailPrimary, organizationId, `{
+        field          = "message"
+        operation      = "contains"
+        expected_value = "critical"
+      },
+      {
+        field     = "tags"
+        operation = "is-empty"
+      }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_alert_policy.test", "filter.conditions.#", "2"),
+					resource.TestCheckResourceAttr("atlassian-operations_alert_policy.test", "filter.conditions.0.order", "0"),
+					resource.TestCheckResourceAttr("atlassian-operations_alert_policy.test", "filter.conditions.1.operation", "is-empty"),
+					resource.TestCheckResourceAttr("atlassian-operations_alert_policy.test", "filter.conditions.1.order", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccAlertPolicyConditionsConfig(alertPolicyName string, teamName string, emailPrimary string, organizationId string, conditions string) string {
+	return `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_alert_policy" "test" {
+  name        = "` + alertPolicyName + `"
+  description = "Test alert policy description"
+  team_id     = atlassian-operations_team.example.id
+  type        = "alert"
+  enabled     = true
+  message     = "Test alert message"
+
+  filter = {
+    type = "match-any-condition"
+    conditions = [
+      ` + conditions + `
+    ]
+  }
+}
+`
+}
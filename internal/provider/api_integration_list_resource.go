@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &ApiIntegrationListResource{}
+var _ list.ListResourceWithConfigure = &ApiIntegrationListResource{}
+
+func NewApiIntegrationListResource() list.ListResource {
+	return &ApiIntegrationListResource{}
+}
+
+// ApiIntegrationListResource lists the API integrations of the site, or of a team.
+// The metadata, configuration and read logic are shared with ApiIntegrationResource.
+type ApiIntegrationListResource struct {
+	ApiIntegrationResource
+}
+
+func (r *ApiIntegrationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.IntegrationListResourceAttributes,
+	}
+}
+
+func (r *ApiIntegrationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data dataModels.TeamScopedListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Trace(ctx, "Listing integrations")
+
+	integrations, err := fetchAllPages[dto.ApiIntegration](r.clientConfiguration, "v1/integrations", nil)
+	if err != nil {
+		stream.Results = listError(ctx, "Unable to list integrations", err)
+		return
+	}
+
+	listed := make([]listedResource, 0, len(integrations))
+	for _, item := range integrations {
+		// Email and webhook integrations are managed by their own resources
+		if item.Type == "Email" || item.Type == "Webhook" || (!data.TeamId.IsNull() && item.TeamId != data.TeamId.ValueString()) {
+			continue
+		}
+		listed = append(listed, listedResource{
+			displayName: item.Name,
+			identity: map[string]types.String{
+				"id": types.StringValue(item.Id),
+			},
+		})
+	}
+
+	stream.Results = listResults(ctx, req, &r.ApiIntegrationResource, listed)
+}
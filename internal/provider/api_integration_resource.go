@@ -13,6 +13,7 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,6 +21,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ApiIntegrationResource{}
 var _ resource.ResourceWithImportState = &ApiIntegrationResource{}
+var _ resource.ResourceWithIdentity = &ApiIntegrationResource{}
 
 func NewApiIntegrationResource() resource.Resource {
 	return &ApiIntegrationResource{}
@@ -40,6 +42,12 @@ func (r *ApiIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+func (r *ApiIntegrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.IdIdentityAttributes,
+	}
+}
+
 func (r *ApiIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring ApiIntegrationResource")
 
@@ -110,6 +118,7 @@ func (r *ApiIntegrationResource) Create(ctx context.Context, req resource.Create
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the ApiIntegrationResource into Terraform state")
 }
 
@@ -157,6 +166,7 @@ func (r *ApiIntegrationResource) Read(ctx context.Context, req resource.ReadRequ
 	tflog.Trace(ctx, "Read the ApiIntegrationResource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the ApiIntegrationResource into Terraform state")
 }
 
@@ -206,6 +216,7 @@ func (r *ApiIntegrationResource) Update(ctx context.Context, req resource.Update
 	tflog.Trace(ctx, "Updated the ApiIntegrationResource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the ApiIntegrationResource into Terraform state")
 }
 
@@ -249,5 +260,11 @@ func (r *ApiIntegrationResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ApiIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
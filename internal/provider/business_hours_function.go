@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &BusinessHoursFunction{}
+
+func NewBusinessHoursFunction() function.Function {
+	return &BusinessHoursFunction{}
+}
+
+// BusinessHoursFunction builds a weekday-and-time-of-day time restriction from a day range and an hour range.
+type BusinessHoursFunction struct{}
+
+func (f *BusinessHoursFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "business_hours"
+}
+
+func (f *BusinessHoursFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a time restriction covering the given hours on the given days.",
+		Description: "Returns a `weekday-and-time-of-day` time restriction with one window per day. " +
+			"Windows ending at or before their start time end on the following day.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "days",
+				Description: "The days the restriction applies to, as a comma separated list of lowercase days or day ranges " +
+					"(e.g. 'monday-friday', 'saturday,sunday' or 'sunday-thursday').",
+			},
+			function.StringParameter{
+				Name:        "hours",
+				Description: "The daily window as 'HH:MM-HH:MM' in 24-hour time. Minutes must be either 00 or 30 (e.g. '09:00-17:30').",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: dataModels.TimeRestrictionModelMap,
+		},
+	}
+}
+
+func (f *BusinessHoursFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var days, hours string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &days, &hours))
+	if resp.Error != nil {
+		return
+	}
+
+	weekdays, err := parseWeekdays(days)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	startHour, startMin, endHour, endMin, err := parseHourRange(hours)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	restrictions := make([]dto.WeekdayTimeRestrictionSettings, len(weekdays))
+	for i, weekday := range weekdays {
+		endDay := weekday
+		if endHour*60+endMin <= startHour*60+startMin {
+			endDay = schemaAttributes.Weekdays[(indexOf(schemaAttributes.Weekdays, weekday)+1)%len(schemaAttributes.Weekdays)]
+		}
+		restrictions[i] = dto.WeekdayTimeRestrictionSettings{
+			StartDay:  dto.Weekday(weekday),
+			EndDay:    dto.Weekday(endDay),
+			StartHour: startHour,
+			EndHour:   endHour,
+			StartMin:  startMin,
+			EndMin:    endMin,
+		}
+	}
+
+	timeRestriction := TimeRestrictionDtoToModel(&dto.TimeRestriction{
+		Type:                        dto.WeekdayAndTimeOfDay,
+		WeekAndTimeOfDayRestriction: &restrictions,
+	})
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, timeRestriction))
+}
+
+// parseWeekdays expands a comma separated list of days and day ranges into the covered days, without duplicates.
+func parseWeekdays(value string) ([]string, error) {
+	weekdays := make([]string, 0)
+	for _, part := range strings.Split(value, ",") {
+		bounds := strings.Split(strings.TrimSpace(part), "-")
+		if len(bounds) > 2 {
+			return nil, fmt.Errorf("invalid day range %q, expected 'day' or 'day-day'", part)
+		}
+
+		start := indexOf(schemaAttributes.Weekdays, strings.ToLower(strings.TrimSpace(bounds[0])))
+		end := indexOf(schemaAttributes.Weekdays, strings.ToLower(strings.TrimSpace(bounds[len(bounds)-1])))
+		if start == -1 || end == -1 {
+			return nil, fmt.Errorf("invalid day range %q, days must be one of: %s", part, strings.Join(schemaAttributes.Weekdays, ", "))
+		}
+
+		for i := start; ; i = (i + 1) % len(schemaAttributes.Weekdays) {
+			if !slices.Contains(weekdays, schemaAttributes.Weekdays[i]) {
+				weekdays = append(weekdays, schemaAttributes.Weekdays[i])
+			}
+			if i == end {
+				break
+			}
+		}
+	}
+	return weekdays, nil
+}
+
+// parseHourRange parses a 'HH:MM-HH:MM' window, accepting the same hours and minutes as the time restriction schema.
+func parseHourRange(value string) (startHour int32, startMin int32, endHour int32, endMin int32, err error) {
+	bounds := strings.Split(strings.TrimSpace(value), "-")
+	if len(bounds) != 2 {
+		return 0, 0, 0, 0, fmt.Errorf("invalid hours %q, expected 'HH:MM-HH:MM'", value)
+	}
+
+	if startHour, startMin, err = parseTimeOfDay(bounds[0]); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if endHour, endMin, err = parseTimeOfDay(bounds[1]); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	return startHour, startMin, endHour, endMin, nil
+}
+
+func parseTimeOfDay(value string) (int32, int32, error) {
+	hour, minute, found := strings.Cut(strings.TrimSpace(value), ":")
+	if !found {
+		return 0, 0, fmt.Errorf("invalid time %q, expected 'HH:MM'", value)
+	}
+
+	h, err := strconv.ParseInt(hour, 10, 32)
+	if err != nil || h < 0 || h > 23 {
+		return 0, 0, fmt.Errorf("invalid time %q, the hour must be between 0 and 23", value)
+	}
+	m, err := strconv.ParseInt(minute, 10, 32)
+	if err != nil || !slices.Contains(schemaAttributes.RestrictionMinutes, int32(m)) {
+		return 0, 0, fmt.Errorf("invalid time %q, the minute must be either 00 or 30", value)
+	}
+	return int32(h), int32(m), nil
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccBusinessHoursFunction(t *testing.T) {
+	weekday := func(startDay string, endDay string, startHour int64, endHour int64, endMin int64) knownvalue.Check {
+		return knownvalue.ObjectExact(map[string]knownvalue.Check{
+			"start_day":  knownvalue.StringExact(startDay),
+			"end_day":    knownvalue.StringExact(endDay),
+			"start_hour": knownvalue.Int32Exact(int32(startHour)),
+			"end_hour":   knownvalue.Int32Exact(int32(endHour)),
+			"start_min":  knownvalue.Int32Exact(0),
+			"end_min":    knownvalue.Int32Exact(int32(endMin)),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Day range
+			{
+				Config: `
+output "test" {
+  value = provider::atlassian-operations::business_hours("monday-wednesday", "09:00-17:30")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"type":        knownvalue.StringExact("weekday-and-time-of-day"),
+						"restriction": knownvalue.Null(),
+						"restrictions": knownvalue.ListExact([]knownvalue.Check{
+							weekday("monday", "monday", 9, 17, 30),
+							weekday("tuesday", "tuesday", 9, 17, 30),
+							weekday("wednesday", "wednesday", 9, 17, 30),
+						}),
+					})),
+				},
+			},
+			// Overnight window wrapping around the end of the week
+			{
+				Config: `
+output "test" {
+  value = provider::atlassian-operations::business_hours("saturday,sunday", "22:00-06:00")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"restrictions": knownvalue.ListExact([]knownvalue.Check{
+							weekday("saturday", "sunday", 22, 6, 0),
+							weekday("sunday", "monday", 22, 6, 0),
+						}),
+					})),
+				},
+			},
+			// Invalid arguments
+			{
+				Config: `
+output "test" {
+  value = provider::atlassian-operations::business_hours("monday-funday", "09:00-17:00")
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid day range`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::atlassian-operations::business_hours("monday", "09:15-17:00")
+}
+`,
+				ExpectError: regexp.MustCompile(`the minute must be\s+either 00 or 30`),
+			},
+		},
+	})
+}
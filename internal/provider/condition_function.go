@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ConditionFunction{}
+
+func NewConditionFunction() function.Function {
+	return &ConditionFunction{}
+}
+
+// ConditionFunction builds a single criteria condition, validating the field, the operation and the expected value.
+type ConditionFunction struct{}
+
+func (f *ConditionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "condition"
+}
+
+func (f *ConditionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a criteria condition.",
+		Description: "Returns a condition object that can be used in the `conditions` list of a criteria. " +
+			"Use `merge()` on the result to set the `key` or `not` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "field",
+				Description: fmt.Sprintf("The alert field to evaluate. One of: %s.", strings.Join(schemaAttributes.CriteriaFields, ", ")),
+			},
+			function.StringParameter{
+				Name:        "operation",
+				Description: fmt.Sprintf("The comparison operation to perform, which must be accepted by the field. One of: %s.", strings.Join(schemaAttributes.CriteriaOperations, ", ")),
+			},
+			function.StringParameter{
+				Name:           "expected_value",
+				Description:    "The value to compare against the field value. May only be null for the 'is-empty' operation.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: dataModels.ConditionModelMap,
+		},
+	}
+}
+
+func (f *ConditionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var field, operation string
+	var expectedValue *string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &field, &operation, &expectedValue))
+	if resp.Error != nil {
+		return
+	}
+
+	operations, ok := schemaAttributes.CriteriaFieldOperations(field)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid field %q, must be one of: %s", field, strings.Join(schemaAttributes.CriteriaFields, ", ")))
+		return
+	}
+	if !slices.Contains(schemaAttributes.CriteriaOperations, operation) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid operation %q, must be one of: %s", operation, strings.Join(schemaAttributes.CriteriaOperations, ", ")))
+		return
+	}
+	if !slices.Contains(operations, operation) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("the %q operation can't be used with the field %q, must be one of: %s", operation, field, strings.Join(operations, ", ")))
+		return
+	}
+	if expectedValue == nil && operation != "is-empty" {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("expected_value must be set for the %q operation", operation))
+		return
+	}
+	if expectedValue != nil && operation == "is-empty" {
+		resp.Error = function.NewArgumentFuncError(2, `expected_value must be null for the "is-empty" operation`)
+		return
+	}
+
+	condition := CriteriaConditionDtoToModel(dto.CriteriaConditionDto{
+		Field:     field,
+		Operation: operation,
+	})
+	condition.ExpectedValue = types.StringPointerValue(expectedValue)
+	condition.Key = types.StringNull()
+	condition.Order = types.Int64Null()
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, condition.AsValue()))
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccConditionFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::atlassian-operations::condition("message", "contains", "error")
+}
+
+output "empty" {
+  value = provider::atlassian-operations::condition("tags", "is-empty", null)
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"field":          knownvalue.StringExact("message"),
+						"operation":      knownvalue.StringExact("contains"),
+						"expected_value": knownvalue.StringExact("error"),
+						"key":            knownvalue.Null(),
+						"not":            knownvalue.Bool(false),
+						"order":          knownvalue.Null(),
+					})),
+					statecheck.ExpectKnownOutputValue("empty", knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"field":          knownvalue.StringExact("tags"),
+						"operation":      knownvalue.StringExact("is-empty"),
+						"expected_value": knownvalue.Null(),
+					})),
+				},
+			},
+			// Invalid arguments
+			{
+				Config: `
+output "test" {
+  value = provider::atlassian-operations::condition("message", "includes", "error")
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid operation "includes"`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::atlassian-operations::condition("message", "contains", null)
+}
+`,
+				ExpectError: regexp.MustCompile(`expected_value must be set`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::atlassian-operations::condition("priority", "contains", "P1")
+}
+`,
+				ExpectError: regexp.MustCompile(`can't\s+be\s+used\s+with\s+the\s+field\s+"priority"`),
+			},
+		},
+	})
+}
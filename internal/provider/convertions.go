@@ -62,55 +62,59 @@ func RotationDtoToModel(scheduleId string, dto dto.Rotation) dataModels.Rotation
 	model.Participants = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.ResponderInfoModelMap}, participants)
 
 	if dto.TimeRestriction != nil {
-		attributes := map[string]attr.Value{
-			"type":        types.StringValue(string(dto.TimeRestriction.Type)),
-			"restriction": types.ObjectNull(dataModels.TimeOfDayTimeRestrictionSettingsModelMap),
-			"restrictions": types.ListNull(
-				types.ObjectType{AttrTypes: dataModels.WeekdayTimeRestrictionSettingsModelMap},
-			),
-		}
+		model.TimeRestriction = TimeRestrictionDtoToModel(dto.TimeRestriction)
+	}
 
-		if dto.TimeRestriction.TimeOfDayRestriction != nil {
-			attributes["restriction"] = types.ObjectValueMust(
-				dataModels.TimeOfDayTimeRestrictionSettingsModelMap,
-				map[string]attr.Value{
-					"start_hour": types.Int32Value(dto.TimeRestriction.TimeOfDayRestriction.StartHour),
-					"end_hour":   types.Int32Value(dto.TimeRestriction.TimeOfDayRestriction.EndHour),
-					"start_min":  types.Int32Value(dto.TimeRestriction.TimeOfDayRestriction.StartMin),
-					"end_min":    types.Int32Value(dto.TimeRestriction.TimeOfDayRestriction.EndMin),
-				},
-			)
-		}
+	return model
+}
 
-		if dto.TimeRestriction.WeekAndTimeOfDayRestriction != nil {
-			restrictions := make([]attr.Value, len(*dto.TimeRestriction.WeekAndTimeOfDayRestriction))
-			for i, restriction := range *dto.TimeRestriction.WeekAndTimeOfDayRestriction {
-				restrictions[i], _ = types.ObjectValue(
-					dataModels.WeekdayTimeRestrictionSettingsModelMap,
-					map[string]attr.Value{
-						"start_day":  types.StringValue(string(restriction.StartDay)),
-						"end_day":    types.StringValue(string(restriction.EndDay)),
-						"start_hour": types.Int32Value(restriction.StartHour),
-						"end_hour":   types.Int32Value(restriction.EndHour),
-						"start_min":  types.Int32Value(restriction.StartMin),
-						"end_min":    types.Int32Value(restriction.EndMin),
-					},
-				)
-			}
+func TimeRestrictionDtoToModel(timeRestriction *dto.TimeRestriction) types.Object {
+	attributes := map[string]attr.Value{
+		"type":        types.StringValue(string(timeRestriction.Type)),
+		"restriction": types.ObjectNull(dataModels.TimeOfDayTimeRestrictionSettingsModelMap),
+		"restrictions": types.ListNull(
+			types.ObjectType{AttrTypes: dataModels.WeekdayTimeRestrictionSettingsModelMap},
+		),
+	}
 
-			attributes["restrictions"] = types.ListValueMust(
-				types.ObjectType{AttrTypes: dataModels.WeekdayTimeRestrictionSettingsModelMap},
-				restrictions,
+	if timeRestriction.TimeOfDayRestriction != nil {
+		attributes["restriction"] = types.ObjectValueMust(
+			dataModels.TimeOfDayTimeRestrictionSettingsModelMap,
+			map[string]attr.Value{
+				"start_hour": types.Int32Value(timeRestriction.TimeOfDayRestriction.StartHour),
+				"end_hour":   types.Int32Value(timeRestriction.TimeOfDayRestriction.EndHour),
+				"start_min":  types.Int32Value(timeRestriction.TimeOfDayRestriction.StartMin),
+				"end_min":    types.Int32Value(timeRestriction.TimeOfDayRestriction.EndMin),
+			},
+		)
+	}
+
+	if timeRestriction.WeekAndTimeOfDayRestriction != nil {
+		restrictions := make([]attr.Value, len(*timeRestriction.WeekAndTimeOfDayRestriction))
+		for i, restriction := range *timeRestriction.WeekAndTimeOfDayRestriction {
+			restrictions[i], _ = types.ObjectValue(
+				dataModels.WeekdayTimeRestrictionSettingsModelMap,
+				map[string]attr.Value{
+					"start_day":  types.StringValue(string(restriction.StartDay)),
+					"end_day":    types.StringValue(string(restriction.EndDay)),
+					"start_hour": types.Int32Value(restriction.StartHour),
+					"end_hour":   types.Int32Value(restriction.EndHour),
+					"start_min":  types.Int32Value(restriction.StartMin),
+					"end_min":    types.Int32Value(restriction.EndMin),
+				},
 			)
 		}
 
-		model.TimeRestriction = types.ObjectValueMust(
-			dataModels.TimeRestrictionModelMap,
-			attributes,
+		attributes["restrictions"] = types.ListValueMust(
+			types.ObjectType{AttrTypes: dataModels.WeekdayTimeRestrictionSettingsModelMap},
+			restrictions,
 		)
 	}
 
-	return model
+	return types.ObjectValueMust(
+		dataModels.TimeRestrictionModelMap,
+		attributes,
+	)
 }
 
 func ScheduleDtoToModel(dto dto.Schedule) dataModels.ScheduleModel {
@@ -211,6 +215,91 @@ func EmailIntegrationDtoToModel(dto dto.EmailIntegration) dataModels.EmailIntegr
 	return model
 }
 
+func WebhookIntegrationModelToDto(ctx context.Context, model dataModels.WebhookIntegrationModel) dto.WebhookIntegration {
+	dtoObj := dto.WebhookIntegration{
+		Id:      model.Id.ValueString(),
+		Name:    model.Name.ValueString(),
+		Enabled: model.Enabled.ValueBool(),
+		TeamId:  model.TeamId.ValueString(),
+		Type:    "Webhook",
+		TypeSpecificProperties: dto.WebhookIntegrationTypeSpecificPropertiesDto{
+			Url:                 model.Url.ValueString(),
+			AddAlertDescription: model.AddAlertDescription.ValueBool(),
+			AddAlertDetails:     model.AddAlertDetails.ValueBool(),
+		},
+	}
+
+	if !(model.Headers.IsNull() || model.Headers.IsUnknown()) {
+		headers := make(map[string]string, len(model.Headers.Elements()))
+		model.Headers.ElementsAs(ctx, &headers, false)
+		dtoObj.TypeSpecificProperties.Headers = headers
+	}
+
+	if !(model.AlertFilter.IsNull() || model.AlertFilter.IsUnknown()) {
+		var alertFilter dataModels.CriteriaModel
+		model.AlertFilter.As(ctx, &alertFilter, basetypes.ObjectAsOptions{})
+		dtoObj.TypeSpecificProperties.AlertFilter = CriteriaModelToDto(ctx, alertFilter)
+	}
+
+	return dtoObj
+}
+
+func WebhookIntegrationDtoToModel(dtoObj dto.WebhookIntegration, oldModel dataModels.WebhookIntegrationModel) dataModels.WebhookIntegrationModel {
+	model := dataModels.WebhookIntegrationModel{
+		Id:                  types.StringValue(dtoObj.Id),
+		Name:                types.StringValue(dtoObj.Name),
+		Enabled:             types.BoolValue(dtoObj.Enabled),
+		Advanced:            types.BoolValue(dtoObj.Advanced),
+		TeamId:              types.StringValue(dtoObj.TeamId),
+		Url:                 types.StringValue(dtoObj.TypeSpecificProperties.Url),
+		AddAlertDescription: types.BoolValue(dtoObj.TypeSpecificProperties.AddAlertDescription),
+		AddAlertDetails:     types.BoolValue(dtoObj.TypeSpecificProperties.AddAlertDetails),
+		Headers:             types.MapNull(types.StringType),
+		AlertFilter:         types.ObjectNull(dataModels.CriteriaModelMap),
+	}
+
+	if dtoObj.TeamId == "" {
+		model.TeamId = types.StringNull()
+	}
+
+	if len(dtoObj.TypeSpecificProperties.Headers) != 0 {
+		headers := make(map[string]attr.Value, len(dtoObj.TypeSpecificProperties.Headers))
+		for key, value := range dtoObj.TypeSpecificProperties.Headers {
+			headers[key] = types.StringValue(value)
+		}
+		model.Headers = types.MapValueMust(types.StringType, headers)
+	} else if !(oldModel.Headers.IsNull() || oldModel.Headers.IsUnknown()) {
+		// Header values may be withheld by the API, keep the configured ones
+		model.Headers = oldModel.Headers
+	}
+
+	if dtoObj.TypeSpecificProperties.AlertFilter != nil {
+		alertFilter := CriteriaDtoToModel(dtoObj.TypeSpecificProperties.AlertFilter)
+		model.AlertFilter = alertFilter.AsValue()
+	}
+
+	directions := make([]attr.Value, len(dtoObj.Directions))
+	for i, direction := range dtoObj.Directions {
+		directions[i] = types.StringValue(direction)
+	}
+	model.Directions, _ = types.ListValue(types.StringType, directions)
+
+	domains := make([]attr.Value, len(dtoObj.Domains))
+	for i, domain := range dtoObj.Domains {
+		domains[i] = types.StringValue(domain)
+	}
+	model.Domains, _ = types.ListValue(types.StringType, domains)
+
+	maintenanceSources := make([]attr.Value, len(dtoObj.MaintenanceSources))
+	for i, maintenanceSource := range dtoObj.MaintenanceSources {
+		toModel := EmailIntegrationMaintenanceSourcesDtoToModel(maintenanceSource)
+		maintenanceSources[i] = toModel.AsValue()
+	}
+	model.MaintenanceSources, _ = types.ListValue(types.ObjectType{AttrTypes: dataModels.IntegrationMaintenanceSourcesResponseModelMap}, maintenanceSources)
+
+	return model
+}
+
 func TeamDtoToModel(dto dto.TeamDto, membersDto []dto.TeamMember) dataModels.TeamModel {
 	model := dataModels.TeamModel{
 		Description:     types.StringValue(dto.Description),
@@ -244,9 +333,16 @@ func TeamDtoToModel(dto dto.TeamDto, membersDto []dto.TeamMember) dataModels.Tea
 }
 
 func TeamMemberDtoToModel(teamMember dto.TeamMember) dataModels.TeamMemberModel {
-	return dataModels.TeamMemberModel{
+	model := dataModels.TeamMemberModel{
 		AccountId: types.StringValue(teamMember.AccountId),
+		Role:      types.StringNull(),
 	}
+
+	if teamMember.Role != "" {
+		model.Role = types.StringValue(teamMember.Role)
+	}
+
+	return model
 }
 
 func PublicApiUserPermissionsDtoToModel(dto dto.PublicApiUserPermissions) dataModels.PublicApiUserPermissionsModel {
@@ -502,6 +598,7 @@ func TeamModelToDto(ctx context.Context, model dataModels.TeamModel) (dto.TeamDt
 func TeamMemberModelToDto(memberModel dataModels.TeamMemberModel) dto.TeamMember {
 	return dto.TeamMember{
 		AccountId: memberModel.AccountId.ValueString(),
+		Role:      memberModel.Role.ValueString(),
 	}
 }
 
@@ -536,7 +633,7 @@ func EscalationRepeatModelToDto(model dataModels.EscalationRepeatModel) dto.Esca
 	}
 }
 
-func EscalationRuleResponseRecipientModelToDto(model dataModels.EscalationRuleResponseRecipientModel) dto.EscalationRuleRecipientDto {
+func EscalationRuleResponseRecipientModelToDto(model dataModels.EscalationRuleResourceRecipientModel) dto.EscalationRuleRecipientDto {
 	return dto.EscalationRuleRecipientDto{
 		Id:   model.Id.ValueString(),
 		Type: model.Type.ValueString(),
@@ -544,7 +641,7 @@ func EscalationRuleResponseRecipientModelToDto(model dataModels.EscalationRuleRe
 }
 
 func EscalationRuleResponseModelToDto(ctx context.Context, model dataModels.EscalationRuleResponseModel) dto.EscalationRuleDto {
-	var recipient dataModels.EscalationRuleResponseRecipientModel
+	var recipient dataModels.EscalationRuleResourceRecipientModel
 	model.Recipient.As(ctx, &recipient, basetypes.ObjectAsOptions{})
 
 	return dto.EscalationRuleDto{
@@ -765,6 +862,63 @@ func ApiIntegrationDtoToModel(dtoObj dto.ApiIntegration, oldModel dataModels.Api
 	return model
 }
 
+func IntegrationDtoToModel(dtoObj dto.ApiIntegration, oldModel dataModels.ApiIntegrationModel) dataModels.ApiIntegrationModel {
+	model := ApiIntegrationDtoToModel(dtoObj, oldModel)
+
+	if dtoObj.TeamId == "" {
+		model.TeamId = types.StringNull()
+	}
+
+	// Only keep the type specific properties managed in the configuration, the API fills in defaults for the rest
+	if !(oldModel.TypeSpecificProperties.IsNull() || oldModel.TypeSpecificProperties.IsUnknown()) {
+		configuredProperties := make(map[string]interface{})
+		if diags := oldModel.TypeSpecificProperties.Unmarshal(&configuredProperties); !diags.HasError() {
+			typeSpecificProperties := make(map[string]interface{})
+			for key, configuredValue := range configuredProperties {
+				if value, ok := dtoObj.TypeSpecificProperties[key]; ok {
+					typeSpecificProperties[key] = value
+				} else {
+					// Write-only properties are not returned by the API
+					typeSpecificProperties[key] = configuredValue
+				}
+			}
+			typeSpecificPropertiesJson, _ := json.Marshal(typeSpecificProperties)
+			model.TypeSpecificProperties = jsontypes.NewExactValue(string(typeSpecificPropertiesJson))
+		}
+	}
+
+	return model
+}
+
+func IntegrationDataSourceDtoToModel(dtoObj dto.ApiIntegration, includeApiKey types.Bool) dataModels.IntegrationDataSourceModel {
+	apiIntegrationModel := ApiIntegrationDtoToModel(dtoObj, dataModels.ApiIntegrationModel{ApiKey: types.StringNull()})
+
+	model := dataModels.IntegrationDataSourceModel{
+		Id:                     apiIntegrationModel.Id,
+		Name:                   apiIntegrationModel.Name,
+		Type:                   apiIntegrationModel.Type,
+		TeamId:                 apiIntegrationModel.TeamId,
+		Enabled:                apiIntegrationModel.Enabled,
+		Advanced:               apiIntegrationModel.Advanced,
+		IncludeApiKey:          includeApiKey,
+		ApiKey:                 types.StringNull(),
+		MaintenanceSources:     apiIntegrationModel.MaintenanceSources,
+		Directions:             apiIntegrationModel.Directions,
+		Domains:                apiIntegrationModel.Domains,
+		TypeSpecificProperties: apiIntegrationModel.TypeSpecificProperties,
+	}
+
+	if dtoObj.TeamId == "" {
+		model.TeamId = types.StringNull()
+	}
+
+	if includeApiKey.ValueBool() {
+		model.ApiKey = apiIntegrationModel.ApiKey
+	}
+
+	return model
+}
+
 func CriteriaConditionModelToDto(model dataModels.CriteriaConditionModel) dto.CriteriaConditionDto {
 	return dto.CriteriaConditionDto{
 		Field:         model.Field.ValueString(),
@@ -807,7 +961,7 @@ func RoutingRuleModelToDto(ctx context.Context, model dataModels.RoutingRuleMode
 	dtoObj := dto.RoutingRuleDto{
 		ID:              model.ID.ValueString(),
 		Name:            model.Name.ValueString(),
-		Order:           model.Order.ValueInt64(),
+		Order:           nil,
 		IsDefault:       model.IsDefault.ValueBool(),
 		Timezone:        model.Timezone.ValueString(),
 		Criteria:        nil,
@@ -815,6 +969,11 @@ func RoutingRuleModelToDto(ctx context.Context, model dataModels.RoutingRuleMode
 		Notify:          nil,
 	}
 
+	// A pointer is used, so that an order of 0 is still sent to the API
+	if !(model.Order.IsNull() || model.Order.IsUnknown()) {
+		dtoObj.Order = model.Order.ValueInt64Pointer()
+	}
+
 	if !(model.TimeRestriction.IsNull() || model.TimeRestriction.IsUnknown()) {
 		var timeRestriction dataModels.TimeRestrictionModel
 		model.TimeRestriction.As(ctx, &timeRestriction, basetypes.ObjectAsOptions{})
@@ -841,7 +1000,7 @@ func RoutingRuleDtoToModel(teamId string, dto dto.RoutingRuleDto) dataModels.Rou
 		ID:              types.StringValue(dto.ID),
 		TeamID:          types.StringValue(teamId),
 		Name:            types.StringValue(dto.Name),
-		Order:           types.Int64Value(dto.Order),
+		Order:           types.Int64PointerValue(dto.Order),
 		IsDefault:       types.BoolValue(dto.IsDefault),
 		Timezone:        types.StringValue(dto.Timezone),
 		TimeRestriction: types.ObjectNull(dataModels.TimeRestrictionModelMap),
@@ -947,6 +1106,17 @@ func RoutingRuleDtoToModel(teamId string, dto dto.RoutingRuleDto) dataModels.Rou
 	return model
 }
 
+func CriteriaConditionDtoToModel(dto dto.CriteriaConditionDto) dataModels.CriteriaConditionModel {
+	return dataModels.CriteriaConditionModel{
+		Field:         types.StringValue(dto.Field),
+		Operation:     types.StringValue(dto.Operation),
+		ExpectedValue: types.StringValue(dto.ExpectedValue),
+		Key:           types.StringValue(dto.Key),
+		Not:           types.BoolValue(dto.Not),
+		Order:         types.Int64Value(int64(dto.Order)),
+	}
+}
+
 func CriteriaDtoToModel(dto *dto.CriteriaDto) dataModels.CriteriaModel {
 	model := dataModels.CriteriaModel{
 		Type: types.StringValue(string(dto.Type)),
@@ -958,17 +1128,8 @@ func CriteriaDtoToModel(dto *dto.CriteriaDto) dataModels.CriteriaModel {
 	if dto.Conditions != nil {
 		conditions := make([]attr.Value, len(*dto.Conditions))
 		for i, condition := range *dto.Conditions {
-			conditions[i] = types.ObjectValueMust(
-				dataModels.ConditionModelMap,
-				map[string]attr.Value{
-					"field":          types.StringValue(condition.Field),
-					"operation":      types.StringValue(condition.Operation),
-					"expected_value": types.StringValue(condition.ExpectedValue),
-					"key":            types.StringValue(condition.Key),
-					"not":            types.BoolValue(condition.Not),
-					"order":          types.Int64Value(int64(condition.Order)),
-				},
-			)
+			conditionModel := CriteriaConditionDtoToModel(condition)
+			conditions[i] = conditionModel.AsValue()
 		}
 
 		model.Conditions = types.ListValueMust(
@@ -1179,6 +1340,7 @@ func NotificationRuleDtoToModel(_ context.Context, dto dto.NotificationRuleDto)
 				dataModels.NotificationRuleStepModelMap,
 				map[string]attr.Value{
 					"contact":    contact,
+					"contact_id": types.StringNull(),
 					"send_after": types.Int64PointerValue(step.SendAfter),
 					"enabled":    types.BoolValue(step.Enabled),
 				},
@@ -2215,6 +2377,7 @@ func HeartbeatDtoToModel(ctx context.Context, dto *dto.HeartbeatDto, teamID stri
 	}
 
 	return &dataModels.HeartbeatModel{
+		Id:            types.StringValue(dto.Id),
 		Name:          types.StringValue(dto.Name),
 		Description:   types.StringValue(dto.Description),
 		Interval:      types.Int64Value(int64(dto.Interval)),
@@ -2228,6 +2391,40 @@ func HeartbeatDtoToModel(ctx context.Context, dto *dto.HeartbeatDto, teamID stri
 	}, diags
 }
 
+func HeartbeatDtoToDataSourceModel(ctx context.Context, dto *dto.HeartbeatDto, teamID string) (dataModels.HeartbeatDataSourceModel, diag.Diagnostics) {
+	alertTags, diags := types.SetValueFrom(ctx, types.StringType, dto.AlertTags)
+	if dto.AlertTags == nil {
+		alertTags = types.SetNull(types.StringType)
+	}
+
+	// The owner team is only returned by some endpoints, the team the heartbeat was read from owns it
+	ownerTeamId := dto.OwnerTeamId
+	if ownerTeamId == "" {
+		ownerTeamId = teamID
+	}
+
+	lastPingTime := types.StringNull()
+	if dto.LastPingTime != "" {
+		lastPingTime = types.StringValue(dto.LastPingTime)
+	}
+
+	return dataModels.HeartbeatDataSourceModel{
+		Id:            types.StringValue(dto.Id),
+		Name:          types.StringValue(dto.Name),
+		Description:   types.StringValue(dto.Description),
+		Interval:      types.Int64Value(int64(dto.Interval)),
+		IntervalUnit:  types.StringValue(dto.IntervalUnit),
+		Enabled:       types.BoolValue(dto.Enabled),
+		Status:        types.StringValue(dto.Status),
+		Expired:       types.BoolValue(dto.Expired),
+		LastPingTime:  lastPingTime,
+		TeamID:        types.StringValue(ownerTeamId),
+		AlertMessage:  types.StringValue(dto.AlertMessage),
+		AlertTags:     alertTags,
+		AlertPriority: types.StringValue(dto.AlertPriority),
+	}, diags
+}
+
 func IntegrationActionModelToDto(ctx context.Context, model *dataModels.IntegrationActionModel) (*dto.IntegrationActionDto, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -2298,6 +2495,25 @@ func IntegrationActionModelToDto(ctx context.Context, model *dataModels.Integrat
 		model.TypeSpecificProperties.Unmarshal(&typeSpecificProperties)
 	}
 
+	if !(model.Webhook.IsNull() || model.Webhook.IsUnknown()) {
+		var webhookModel dataModels.WebhookActionPropertiesModel
+		diags.Append(model.Webhook.As(ctx, &webhookModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if !(webhookModel.Url.IsNull() || webhookModel.Url.IsUnknown()) {
+			typeSpecificProperties["url"] = webhookModel.Url.ValueString()
+		}
+		if !(webhookModel.Headers.IsNull() || webhookModel.Headers.IsUnknown()) {
+			headers := make(map[string]string, len(webhookModel.Headers.Elements()))
+			diags.Append(webhookModel.Headers.ElementsAs(ctx, &headers, false)...)
+			typeSpecificProperties["headers"] = headers
+		}
+		typeSpecificProperties["addAlertDescription"] = webhookModel.AddAlertDescription.ValueBool()
+		typeSpecificProperties["addAlertDetails"] = webhookModel.AddAlertDetails.ValueBool()
+	}
+
 	fieldMappings := make(map[string]interface{})
 	if !(model.FieldMappings.IsNull() || model.FieldMappings.IsUnknown()) {
 		model.FieldMappings.Unmarshal(&fieldMappings)
@@ -2392,6 +2608,32 @@ func IntegrationActionDtoToModel(ctx context.Context, dto *dto.IntegrationAction
 		enabled = types.BoolPointerValue(dto.Enabled)
 	}
 
+	webhook := types.ObjectNull(dataModels.WebhookActionPropertiesModelMap)
+	if model != nil && !(model.Webhook.IsNull() || model.Webhook.IsUnknown()) {
+		var oldWebhook dataModels.WebhookActionPropertiesModel
+		diags.Append(model.Webhook.As(ctx, &oldWebhook, basetypes.ObjectAsOptions{})...)
+
+		webhookModel := dataModels.WebhookActionPropertiesModel{
+			Url:                 oldWebhook.Url,
+			Headers:             oldWebhook.Headers,
+			AddAlertDescription: oldWebhook.AddAlertDescription,
+			AddAlertDetails:     oldWebhook.AddAlertDetails,
+		}
+		if url, ok := dto.TypeSpecificProperties["url"].(string); ok && !oldWebhook.Url.IsNull() {
+			webhookModel.Url = types.StringValue(url)
+		}
+		if addAlertDescription, ok := dto.TypeSpecificProperties["addAlertDescription"].(bool); ok {
+			webhookModel.AddAlertDescription = types.BoolValue(addAlertDescription)
+		}
+		if addAlertDetails, ok := dto.TypeSpecificProperties["addAlertDetails"].(bool); ok {
+			webhookModel.AddAlertDetails = types.BoolValue(addAlertDetails)
+		}
+		webhook = webhookModel.AsValue()
+
+		// Headers are sensitive, keep them out of the plain type_specific_properties attribute
+		delete(dto.TypeSpecificProperties, "headers")
+	}
+
 	typeSpecificPropsMap, _ := json.Marshal(dto.TypeSpecificProperties)
 
 	fieldMappingsMap, _ := json.Marshal(dto.FieldMappings)
@@ -2408,6 +2650,7 @@ func IntegrationActionDtoToModel(ctx context.Context, dto *dto.IntegrationAction
 		TypeSpecificProperties: jsontypes.NewExactValue(string(typeSpecificPropsMap)),
 		FieldMappings:          jsontypes.NewExactValue(string(fieldMappingsMap)),
 		ActionMapping:          actionMapping,
+		Webhook:                webhook,
 		Enabled:                enabled,
 	}, diags
 }
@@ -2510,13 +2753,30 @@ func MaintenanceDtoToModel(ctx context.Context, dtoObj *dto.MaintenanceDto) (*da
 		teamId = types.StringValue(dtoObj.TeamID)
 	}
 
+	startDate, startDateDiags := timetypes.NewRFC3339Value(dtoObj.StartDate)
+	diags.Append(startDateDiags...)
+	endDate, endDateDiags := timetypes.NewRFC3339Value(dtoObj.EndDate)
+	diags.Append(endDateDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	return &dataModels.MaintenanceModel{
 		ID:          types.StringValue(dtoObj.ID),
 		Status:      types.StringValue(dtoObj.Status),
 		Description: types.StringValue(dtoObj.Description),
-		StartDate:   types.StringValue(dtoObj.StartDate),
-		EndDate:     types.StringValue(dtoObj.EndDate),
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Schedule:    types.ObjectNull(dataModels.MaintenanceScheduleObjectType.AttrTypes),
+		NextStart:   timetypes.NewRFC3339Null(),
 		TeamID:      teamId,
-		Rules:       rulesList,
+		// The rules are not expanded from typed attributes unless configured
+		IntegrationIds: types.SetNull(types.StringType),
+		PolicyIds:      types.SetNull(types.StringType),
+		SyncIds:        types.SetNull(types.StringType),
+		State:          types.StringNull(),
+		Rules:          rulesList,
+		// Maintenance windows are deleted on destroy unless configured otherwise
+		CancelOnDestroy: types.BoolValue(false),
 	}, diags
 }
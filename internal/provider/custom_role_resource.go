@@ -11,6 +11,7 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,6 +20,7 @@ var (
 	_ resource.Resource                = &CustomRoleResource{}
 	_ resource.ResourceWithConfigure   = &CustomRoleResource{}
 	_ resource.ResourceWithImportState = &CustomRoleResource{}
+	_ resource.ResourceWithIdentity    = &CustomRoleResource{}
 )
 
 type CustomRoleResource struct {
@@ -39,6 +41,12 @@ func (r *CustomRoleResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *CustomRoleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.IdIdentityAttributes,
+	}
+}
+
 func (r *CustomRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring CustomRoleResource")
 
@@ -109,6 +117,7 @@ func (r *CustomRoleResource) Create(ctx context.Context, req resource.CreateRequ
 	// Update state with response
 	result := CustomRoleCUDDtoToModel(&customRoleCUDDto, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CustomRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -155,6 +164,7 @@ func (r *CustomRoleResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	result := CustomRoleDtoToModel(&customRoleDto)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CustomRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -204,6 +214,7 @@ func (r *CustomRoleResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	result := CustomRoleCUDDtoToModel(&customRoleCUDDto, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CustomRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -246,5 +257,34 @@ func (r *CustomRoleResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *CustomRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
+	if scope, name, found := splitNameImportId(req.ID); found {
+		// Imported by name, custom roles are not owned by a team so the scope must be left empty
+		if scope != "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: id; or: /name. Got: %q", req.ID),
+			)
+			return
+		}
+
+		id, err := lookupIdByName(r.clientConfiguration, "custom role", "/v1/roles", nil, name, func(item dto.CustomRoleDto) (string, string) {
+			return item.Name, item.ID
+		})
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Unable to import custom role, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import custom role, got error: %s", err))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
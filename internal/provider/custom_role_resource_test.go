@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccCustomRoleResource(t *testing.T) {
@@ -49,6 +50,21 @@ resource "atlassian-operations_custom_role" "test" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import by identity
+			{
+				ResourceName:    "atlassian-operations_custom_role.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			// ImportState by name testing
+			{
+				ResourceName:      "atlassian-operations_custom_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return "/" + state.RootModule().Resources["atlassian-operations_custom_role.test"].Primary.Attributes["name"], nil
+				},
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
@@ -0,0 +1,23 @@
+package dataModels
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type HeartbeatPingActionModel struct {
+	TeamId types.String `tfsdk:"team_id"`
+	Name   types.String `tfsdk:"name"`
+}
+
+type MaintenanceCancelActionModel struct {
+	Id     types.String `tfsdk:"id"`
+	TeamId types.String `tfsdk:"team_id"`
+}
+
+type SendTestAlertActionModel struct {
+	ApiKey      types.String `tfsdk:"api_key"`
+	Message     types.String `tfsdk:"message"`
+	Alias       types.String `tfsdk:"alias"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.List   `tfsdk:"tags"`
+	Details     types.Map    `tfsdk:"details"`
+	Priority    types.String `tfsdk:"priority"`
+}
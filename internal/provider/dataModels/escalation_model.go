@@ -25,6 +25,14 @@ type (
 		Id   types.String `tfsdk:"id"`
 		Type types.String `tfsdk:"type"`
 	}
+	// EscalationRuleResourceRecipientModel is the recipient of the rules of the escalation resource, which can also be
+	// referenced by email or name
+	EscalationRuleResourceRecipientModel struct {
+		Id    types.String `tfsdk:"id"`
+		Type  types.String `tfsdk:"type"`
+		Email types.String `tfsdk:"email"`
+		Name  types.String `tfsdk:"name"`
+	}
 	EscalationRepeatModel struct {
 		WaitInterval         types.Int32 `tfsdk:"wait_interval"`
 		Count                types.Int32 `tfsdk:"count"`
@@ -45,6 +53,20 @@ var EscalationRuleResponseModelMap = map[string]attr.Type{
 	"recipient":   types.ObjectType{AttrTypes: EscalationRuleResponseRecipientModelMap},
 }
 
+var EscalationRuleResourceRecipientModelMap = map[string]attr.Type{
+	"id":    types.StringType,
+	"type":  types.StringType,
+	"email": types.StringType,
+	"name":  types.StringType,
+}
+
+var EscalationRuleResourceModelMap = map[string]attr.Type{
+	"condition":   types.StringType,
+	"notify_type": types.StringType,
+	"delay":       types.Int64Type,
+	"recipient":   types.ObjectType{AttrTypes: EscalationRuleResourceRecipientModelMap},
+}
+
 var EscalationRepeatModelMap = map[string]attr.Type{
 	"wait_interval":          types.Int32Type,
 	"count":                  types.Int32Type,
@@ -69,6 +91,15 @@ func (receiver *EscalationRuleResponseRecipientModel) AsValue() types.Object {
 	})
 }
 
+func (receiver *EscalationRuleResourceRecipientModel) AsValue() types.Object {
+	return types.ObjectValueMust(EscalationRuleResourceRecipientModelMap, map[string]attr.Value{
+		"id":    receiver.Id,
+		"type":  receiver.Type,
+		"email": receiver.Email,
+		"name":  receiver.Name,
+	})
+}
+
 func (receiver *EscalationRuleResponseModel) AsValue() types.Object {
 	return types.ObjectValueMust(EscalationRuleResponseModelMap, map[string]attr.Value{
 		"condition":   receiver.Condition,
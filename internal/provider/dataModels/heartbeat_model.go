@@ -1,15 +1,18 @@
 package dataModels
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // HeartbeatModel maps our data source attributes
 type HeartbeatModel struct {
+	Id            types.String `tfsdk:"id"`
 	Name          types.String `tfsdk:"name"`
 	Description   types.String `tfsdk:"description"`
 	Interval      types.Int64  `tfsdk:"interval"`
 	IntervalUnit  types.String `tfsdk:"interval_unit"`
+	Period        types.String `tfsdk:"period"`
 	Enabled       types.Bool   `tfsdk:"enabled"`
 	Status        types.String `tfsdk:"status"`
 	TeamID        types.String `tfsdk:"team_id"`
@@ -17,3 +20,43 @@ type HeartbeatModel struct {
 	AlertTags     types.Set    `tfsdk:"alert_tags"`
 	AlertPriority types.String `tfsdk:"alert_priority"`
 }
+
+type (
+	// HeartbeatDataSourceModel maps the attributes of the heartbeat data source, and of the heartbeats listed by the
+	// heartbeats data source
+	HeartbeatDataSourceModel struct {
+		Id            types.String `tfsdk:"id"`
+		Name          types.String `tfsdk:"name"`
+		Description   types.String `tfsdk:"description"`
+		Interval      types.Int64  `tfsdk:"interval"`
+		IntervalUnit  types.String `tfsdk:"interval_unit"`
+		Enabled       types.Bool   `tfsdk:"enabled"`
+		Status        types.String `tfsdk:"status"`
+		Expired       types.Bool   `tfsdk:"expired"`
+		LastPingTime  types.String `tfsdk:"last_ping_time"`
+		TeamID        types.String `tfsdk:"team_id"`
+		AlertMessage  types.String `tfsdk:"alert_message"`
+		AlertTags     types.Set    `tfsdk:"alert_tags"`
+		AlertPriority types.String `tfsdk:"alert_priority"`
+	}
+	HeartbeatsDataSourceModel struct {
+		TeamID     types.String `tfsdk:"team_id"`
+		Heartbeats types.List   `tfsdk:"heartbeats"`
+	}
+)
+
+var HeartbeatDataSourceModelMap = map[string]attr.Type{
+	"id":             types.StringType,
+	"name":           types.StringType,
+	"description":    types.StringType,
+	"interval":       types.Int64Type,
+	"interval_unit":  types.StringType,
+	"enabled":        types.BoolType,
+	"status":         types.StringType,
+	"expired":        types.BoolType,
+	"last_ping_time": types.StringType,
+	"team_id":        types.StringType,
+	"alert_message":  types.StringType,
+	"alert_tags":     types.SetType{ElemType: types.StringType},
+	"alert_priority": types.StringType,
+}
@@ -18,6 +18,7 @@ type IntegrationActionModel struct {
 	TypeSpecificProperties jsontypes.Exact `tfsdk:"type_specific_properties"`
 	FieldMappings          jsontypes.Exact `tfsdk:"field_mappings"`
 	ActionMapping          types.Object    `tfsdk:"action_mapping"`
+	Webhook                types.Object    `tfsdk:"webhook"`
 	Enabled                types.Bool      `tfsdk:"enabled"`
 }
 
@@ -37,6 +38,13 @@ type FilterConditionModel struct {
 	SystemCondition types.Bool   `tfsdk:"system_condition"`
 }
 
+type WebhookActionPropertiesModel struct {
+	Url                 types.String `tfsdk:"url"`
+	Headers             types.Map    `tfsdk:"headers"`
+	AddAlertDescription types.Bool   `tfsdk:"add_alert_description"`
+	AddAlertDetails     types.Bool   `tfsdk:"add_alert_details"`
+}
+
 type ActionMappingModel struct {
 	Type      types.String    `tfsdk:"type"`
 	Parameter jsontypes.Exact `tfsdk:"parameter"`
@@ -63,6 +71,13 @@ var ActionMappingModelMap = map[string]attr.Type{
 	"parameter": jsontypes.ExactType{},
 }
 
+var WebhookActionPropertiesModelMap = map[string]attr.Type{
+	"url":                   types.StringType,
+	"headers":               types.MapType{ElemType: types.StringType},
+	"add_alert_description": types.BoolType,
+	"add_alert_details":     types.BoolType,
+}
+
 var IntegrationActionModelMap = map[string]attr.Type{
 	"id":                       types.StringType,
 	"integration_id":           types.StringType,
@@ -75,6 +90,7 @@ var IntegrationActionModelMap = map[string]attr.Type{
 	"type_specific_properties": jsontypes.ExactType{},
 	"field_mappings":           jsontypes.ExactType{},
 	"action_mapping":           types.ObjectType{AttrTypes: ActionMappingModelMap},
+	"webhook":                  types.ObjectType{AttrTypes: WebhookActionPropertiesModelMap},
 	"enabled":                  types.BoolType,
 }
 
@@ -91,6 +107,7 @@ func (m *IntegrationActionModel) AsValue() types.Object {
 		"type_specific_properties": m.TypeSpecificProperties,
 		"field_mappings":           m.FieldMappings,
 		"action_mapping":           m.ActionMapping,
+		"webhook":                  m.Webhook,
 		"enabled":                  m.Enabled,
 	})
 }
@@ -111,6 +128,15 @@ func (m *FilterConditionModel) AsValue() types.Object {
 	})
 }
 
+func (m *WebhookActionPropertiesModel) AsValue() types.Object {
+	return types.ObjectValueMust(WebhookActionPropertiesModelMap, map[string]attr.Value{
+		"url":                   m.Url,
+		"headers":               m.Headers,
+		"add_alert_description": m.AddAlertDescription,
+		"add_alert_details":     m.AddAlertDetails,
+	})
+}
+
 func (m *ActionMappingModel) AsValue() types.Object {
 	return types.ObjectValueMust(ActionMappingModelMap, map[string]attr.Value{
 		"type":      m.Type,
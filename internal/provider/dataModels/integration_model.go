@@ -0,0 +1,23 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type (
+	IntegrationDataSourceModel struct {
+		Id                     types.String    `tfsdk:"id"`
+		Name                   types.String    `tfsdk:"name"`
+		Type                   types.String    `tfsdk:"type"`
+		TeamId                 types.String    `tfsdk:"team_id"`
+		Enabled                types.Bool      `tfsdk:"enabled"`
+		Advanced               types.Bool      `tfsdk:"advanced"`
+		IncludeApiKey          types.Bool      `tfsdk:"include_api_key"`
+		ApiKey                 types.String    `tfsdk:"api_key"`
+		MaintenanceSources     types.List      `tfsdk:"maintenance_sources"`
+		Directions             types.List      `tfsdk:"directions"`
+		Domains                types.List      `tfsdk:"domains"`
+		TypeSpecificProperties jsontypes.Exact `tfsdk:"type_specific_properties"`
+	}
+)
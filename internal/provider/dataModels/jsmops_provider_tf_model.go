@@ -9,6 +9,7 @@ type AtlassianOpsProviderTfModel struct {
 	EmailAddress    types.String `tfsdk:"email_address"`
 	Token           types.String `tfsdk:"token"`
 	OrgAdminToken   types.String `tfsdk:"org_admin_token"`
+	OrganizationId  types.String `tfsdk:"organization_id"`
 	ApiRetryCount   types.Int32  `tfsdk:"api_retry_count"`
 	ApiRetryWait    types.Int32  `tfsdk:"api_retry_wait"`
 	ApiRetryWaitMax types.Int32  `tfsdk:"api_retry_wait_max"`
@@ -0,0 +1,15 @@
+package dataModels
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type TeamListResourceModel struct {
+	OrganizationId types.String `tfsdk:"organization_id"`
+}
+
+type ScheduleRotationListResourceModel struct {
+	ScheduleId types.String `tfsdk:"schedule_id"`
+}
+
+type TeamScopedListResourceModel struct {
+	TeamId types.String `tfsdk:"team_id"`
+}
@@ -1,19 +1,37 @@
 package dataModels
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // MaintenanceModel represents the Terraform resource data model for a maintenance window
 type MaintenanceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Description types.String `tfsdk:"description"`
-	StartDate   types.String `tfsdk:"start_date"`
-	EndDate     types.String `tfsdk:"end_date"`
-	Status      types.String `tfsdk:"status"`
-	TeamID      types.String `tfsdk:"team_id"`
-	Rules       types.List   `tfsdk:"rules"`
+	ID          types.String      `tfsdk:"id"`
+	Description types.String      `tfsdk:"description"`
+	StartDate   timetypes.RFC3339 `tfsdk:"start_date"`
+	EndDate     timetypes.RFC3339 `tfsdk:"end_date"`
+	Schedule    types.Object      `tfsdk:"schedule"`
+	NextStart   timetypes.RFC3339 `tfsdk:"next_start"`
+	Status      types.String      `tfsdk:"status"`
+	TeamID      types.String      `tfsdk:"team_id"`
+	// IntegrationIds, PolicyIds, SyncIds and State are only used by the provider, they are expanded into Rules
+	IntegrationIds types.Set    `tfsdk:"integration_ids"`
+	PolicyIds      types.Set    `tfsdk:"policy_ids"`
+	SyncIds        types.Set    `tfsdk:"sync_ids"`
+	State          types.String `tfsdk:"state"`
+	Rules          types.List   `tfsdk:"rules"`
+	// CancelOnDestroy is only used by the provider, it is not sent to the API
+	CancelOnDestroy types.Bool `tfsdk:"cancel_on_destroy"`
+}
+
+// MaintenanceScheduleModel represents the weekly recurrence of a maintenance window
+type MaintenanceScheduleModel struct {
+	Days      types.Set    `tfsdk:"days"`
+	StartTime types.String `tfsdk:"start_time"`
+	Timezone  types.String `tfsdk:"timezone"`
+	Duration  types.String `tfsdk:"duration"`
 }
 
 // MaintenanceRuleModel represents a rule within a maintenance window for Terraform
@@ -48,3 +66,13 @@ var MaintenanceRuleObjectType = types.ObjectType{
 		},
 	},
 }
+
+// MaintenanceScheduleObjectType defines the type for the schedule object
+var MaintenanceScheduleObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"days":       types.SetType{ElemType: types.StringType},
+		"start_time": types.StringType,
+		"timezone":   types.StringType,
+		"duration":   types.StringType,
+	},
+}
@@ -66,6 +66,7 @@ func (c NotificationRuleConditionModel) AsValue() types.Object {
 type NotificationRuleStepModel struct {
 	SendAfter types.Int64  `tfsdk:"send_after"`
 	Contact   types.Object `tfsdk:"contact"`
+	ContactId types.String `tfsdk:"contact_id"`
 	Enabled   types.Bool   `tfsdk:"enabled"`
 }
 
@@ -73,6 +74,7 @@ func (s NotificationRuleStepModel) AsValue() types.Object {
 	return types.ObjectValueMust(NotificationRuleStepModelMap, map[string]attr.Value{
 		"send_after": s.SendAfter,
 		"contact":    s.Contact,
+		"contact_id": s.ContactId,
 		"enabled":    s.Enabled,
 	})
 }
@@ -143,7 +145,8 @@ var NotificationRuleStepModelMap = map[string]attr.Type{
 	"contact": types.ObjectType{
 		AttrTypes: NotificationContactModelMap,
 	},
-	"enabled": types.BoolType,
+	"contact_id": types.StringType,
+	"enabled":    types.BoolType,
 }
 
 var NotificationContactModelMap = map[string]attr.Type{
@@ -0,0 +1,12 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PolicyOrderModel struct {
+	Id         types.String `tfsdk:"id"`
+	TeamId     types.String `tfsdk:"team_id"`
+	PolicyType types.String `tfsdk:"policy_type"`
+	PolicyIds  types.List   `tfsdk:"policy_ids"`
+}
@@ -0,0 +1,12 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TeamMemberResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	TeamId         types.String `tfsdk:"team_id"`
+	AccountId      types.String `tfsdk:"account_id"`
+}
@@ -16,6 +16,10 @@ type (
 		UserPermissions types.Object `tfsdk:"user_permissions"`
 		Member          types.Set    `tfsdk:"member"`
 	}
+	TeamResourceModel struct {
+		TeamModel
+		AuthoritativeMembership types.Bool `tfsdk:"authoritative_membership"`
+	}
 	PublicApiUserPermissionsModel struct {
 		AddMembers    types.Bool `tfsdk:"add_members"`
 		DeleteTeam    types.Bool `tfsdk:"delete_team"`
@@ -24,6 +28,7 @@ type (
 	}
 	TeamMemberModel struct {
 		AccountId types.String `tfsdk:"account_id"`
+		Role      types.String `tfsdk:"role"`
 	}
 )
 
@@ -44,6 +49,7 @@ var TeamModelMap = map[string]attr.Type{
 
 var TeamMemberModelMap = map[string]attr.Type{
 	"account_id": types.StringType,
+	"role":       types.StringType,
 }
 
 var PublicApiUserPermissionsModelMap = map[string]attr.Type{
@@ -78,5 +84,6 @@ func (receiver PublicApiUserPermissionsModel) AsValue() types.Object {
 func (receiver *TeamMemberModel) AsValue() types.Object {
 	return types.ObjectValueMust(TeamMemberModelMap, map[string]attr.Value{
 		"account_id": receiver.AccountId,
+		"role":       receiver.Role,
 	})
 }
@@ -0,0 +1,11 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TeamRoutingRuleOrderModel struct {
+	Id             types.String `tfsdk:"id"`
+	TeamId         types.String `tfsdk:"team_id"`
+	RoutingRuleIds types.List   `tfsdk:"routing_rule_ids"`
+}
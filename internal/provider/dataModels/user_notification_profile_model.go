@@ -0,0 +1,77 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UserNotificationProfileModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Contacts types.Map    `tfsdk:"contacts"`
+	Rules    types.Map    `tfsdk:"rules"`
+}
+
+type UserNotificationProfileContactModel struct {
+	ID      types.String `tfsdk:"id"`
+	Method  types.String `tfsdk:"method"`
+	To      types.String `tfsdk:"to"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+func (c UserNotificationProfileContactModel) AsValue() types.Object {
+	return types.ObjectValueMust(UserNotificationProfileContactModelMap, map[string]attr.Value{
+		"id":      c.ID,
+		"method":  c.Method,
+		"to":      c.To,
+		"enabled": c.Enabled,
+	})
+}
+
+type UserNotificationProfileRuleModel struct {
+	ID      types.String `tfsdk:"id"`
+	Steps   types.List   `tfsdk:"steps"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+func (r UserNotificationProfileRuleModel) AsValue() types.Object {
+	return types.ObjectValueMust(UserNotificationProfileRuleModelMap, map[string]attr.Value{
+		"id":      r.ID,
+		"steps":   r.Steps,
+		"enabled": r.Enabled,
+	})
+}
+
+type UserNotificationProfileStepModel struct {
+	Contact   types.String `tfsdk:"contact"`
+	SendAfter types.Int64  `tfsdk:"send_after"`
+}
+
+func (s UserNotificationProfileStepModel) AsValue() types.Object {
+	return types.ObjectValueMust(UserNotificationProfileStepModelMap, map[string]attr.Value{
+		"contact":    s.Contact,
+		"send_after": s.SendAfter,
+	})
+}
+
+var UserNotificationProfileContactModelMap = map[string]attr.Type{
+	"id":      types.StringType,
+	"method":  types.StringType,
+	"to":      types.StringType,
+	"enabled": types.BoolType,
+}
+
+var UserNotificationProfileRuleModelMap = map[string]attr.Type{
+	"id": types.StringType,
+	"steps": types.ListType{
+		ElemType: types.ObjectType{
+			AttrTypes: UserNotificationProfileStepModelMap,
+		},
+	},
+	"enabled": types.BoolType,
+}
+
+var UserNotificationProfileStepModelMap = map[string]attr.Type{
+	"contact":    types.StringType,
+	"send_after": types.Int64Type,
+}
@@ -0,0 +1,23 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type (
+	WebhookIntegrationModel struct {
+		Id                  types.String `tfsdk:"id"`
+		Name                types.String `tfsdk:"name"`
+		Enabled             types.Bool   `tfsdk:"enabled"`
+		TeamId              types.String `tfsdk:"team_id"`
+		Advanced            types.Bool   `tfsdk:"advanced"`
+		Directions          types.List   `tfsdk:"directions"`
+		Domains             types.List   `tfsdk:"domains"`
+		MaintenanceSources  types.List   `tfsdk:"maintenance_sources"`
+		Url                 types.String `tfsdk:"url"`
+		Headers             types.Map    `tfsdk:"headers"`
+		AddAlertDescription types.Bool   `tfsdk:"add_alert_description"`
+		AddAlertDetails     types.Bool   `tfsdk:"add_alert_details"`
+		AlertFilter         types.Object `tfsdk:"alert_filter"`
+	}
+)
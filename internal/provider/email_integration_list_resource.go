@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &EmailIntegrationListResource{}
+var _ list.ListResourceWithConfigure = &EmailIntegrationListResource{}
+
+func NewEmailIntegrationListResource() list.ListResource {
+	return &EmailIntegrationListResource{}
+}
+
+// EmailIntegrationListResource lists the email integrations of the site, or of a team.
+// The metadata, configuration and read logic are shared with EmailIntegrationResource.
+type EmailIntegrationListResource struct {
+	EmailIntegrationResource
+}
+
+func (r *EmailIntegrationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.IntegrationListResourceAttributes,
+	}
+}
+
+func (r *EmailIntegrationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data dataModels.TeamScopedListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Trace(ctx, "Listing integrations")
+
+	integrations, err := fetchAllPages[dto.ApiIntegration](r.clientConfiguration, "v1/integrations", nil)
+	if err != nil {
+		stream.Results = listError(ctx, "Unable to list integrations", err)
+		return
+	}
+
+	listed := make([]listedResource, 0, len(integrations))
+	for _, item := range integrations {
+		if item.Type != "Email" || (!data.TeamId.IsNull() && item.TeamId != data.TeamId.ValueString()) {
+			continue
+		}
+		listed = append(listed, listedResource{
+			displayName: item.Name,
+			identity: map[string]types.String{
+				"id": types.StringValue(item.Id),
+			},
+		})
+	}
+
+	stream.Results = listResults(ctx, req, &r.EmailIntegrationResource, listed)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,6 +21,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &EmailIntegrationResource{}
 var _ resource.ResourceWithImportState = &EmailIntegrationResource{}
+var _ resource.ResourceWithIdentity = &EmailIntegrationResource{}
 
 func NewEmailIntegrationResource() resource.Resource {
 	return &EmailIntegrationResource{}
@@ -40,6 +42,12 @@ func (r *EmailIntegrationResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+func (r *EmailIntegrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.IdIdentityAttributes,
+	}
+}
+
 func (r *EmailIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring EmailIntegrationResource")
 
@@ -110,6 +118,7 @@ func (r *EmailIntegrationResource) Create(ctx context.Context, req resource.Crea
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the EmailIntegrationResource into Terraform state")
 }
 
@@ -157,6 +166,7 @@ func (r *EmailIntegrationResource) Read(ctx context.Context, req resource.ReadRe
 	tflog.Trace(ctx, "Read the EmailIntegrationResource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the EmailIntegrationResource into Terraform state")
 }
 
@@ -210,6 +220,7 @@ func (r *EmailIntegrationResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the EmailIntegrationResource into Terraform state")
 }
 
@@ -253,5 +264,11 @@ func (r *EmailIntegrationResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *EmailIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &EscalationDataSource{}
+	_ datasource.DataSourceWithConfigure = &EscalationDataSource{}
+)
+
+func NewEscalationDataSource() datasource.DataSource {
+	return &EscalationDataSource{}
+}
+
+// EscalationDataSource defines the data source implementation.
+type EscalationDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *EscalationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_escalation"
+}
+
+func (d *EscalationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Escalation data source",
+		Attributes:          schemaAttributes.EscalationDataSourceAttributes,
+	}
+}
+
+func (d *EscalationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring escalation_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure escalation_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured escalation_data_source")
+}
+
+func (d *EscalationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.EscalationModel
+
+	tflog.Trace(ctx, "Reading escalation data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read escalation configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	teamId := model.TeamId.ValueString()
+	escalationId := model.Id.ValueString()
+
+	if model.Id.IsNull() {
+		tflog.Trace(ctx, "Looking up the escalation by name")
+
+		escalations, err := fetchAllPages[dto.EscalationDto](d.clientConfiguration, fmt.Sprintf("/v1/teams/%s/escalations", teamId), nil)
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to list escalations, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list escalations, got error: %s", err))
+			return
+		}
+
+		var candidates []string
+		for _, escalation := range escalations {
+			if escalation.Name == model.Name.ValueString() {
+				candidates = append(candidates, escalation.Id)
+			}
+		}
+
+		if len(candidates) == 0 {
+			tflog.Error(ctx, "No escalations found")
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("No escalation named '%s' found in team %s", model.Name.ValueString(), teamId))
+			return
+		} else if len(candidates) > 1 {
+			tflog.Error(ctx, "Multiple escalations found")
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Multiple escalations named '%s' found in team %s: %s. Use id to select one of them.", model.Name.ValueString(), teamId, strings.Join(candidates, ", ")))
+			return
+		}
+
+		escalationId = candidates[0]
+	}
+
+	tflog.Trace(ctx, "Sending HTTP request to JSM OPS API")
+
+	escalationDto := dto.EscalationDto{}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(d.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/escalations/%s", teamId, escalationId)).
+		Method(httpClient.GET).
+		SetBodyParseObject(&escalationDto).
+		Send()
+
+	if httpResp == nil {
+		tflog.Error(ctx, "Client Error. Unable to read escalation, got nil response")
+		resp.Diagnostics.AddError("Client Error", "Unable to read escalation, got nil response")
+	} else if httpResp.IsError() {
+		statusCode := httpResp.GetStatusCode()
+		errorResponse := httpResp.GetErrorBody()
+		if errorResponse != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read escalation, status code: %d. Got response: %s", statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read escalation, status code: %d. Got response: %s", statusCode, *errorResponse))
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read escalation, got http response: %d", statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read escalation, got http response: %d", statusCode))
+		}
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read escalation, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read escalation or to parse received data, got error: %s", err))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP request to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
+	model = EscalationDtoToModel(teamId, escalationDto)
+
+	tflog.Trace(ctx, "Successfully read escalation data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEscalationDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	escalationName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_escalation" "example" {
+  name    = "` + escalationName + `"
+  team_id = atlassian-operations_team.example.id
+  description = "escalation description"
+  rules = [{
+	condition = "if-not-acked"
+	notify_type = "default"
+    delay = 5
+    recipient = {
+    	id = data.atlassian-operations_user.test1.account_id
+		type = "user"
+    }
+  }]
+  enabled = true
+}
+
+data "atlassian-operations_escalation" "by_name" {
+	depends_on = ["atlassian-operations_escalation.example"]
+	team_id = atlassian-operations_team.example.id
+	name = "` + escalationName + `"
+}
+
+data "atlassian-operations_escalation" "by_id" {
+	team_id = atlassian-operations_team.example.id
+	id = atlassian-operations_escalation.example.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_name", "id", "atlassian-operations_escalation.example", "id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_name", "name", "atlassian-operations_escalation.example", "name"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_name", "team_id", "atlassian-operations_escalation.example", "team_id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_name", "description", "atlassian-operations_escalation.example", "description"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_name", "enabled", "atlassian-operations_escalation.example", "enabled"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_name", "rules.#", "atlassian-operations_escalation.example", "rules.#"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_name", "repeat.wait_interval", "atlassian-operations_escalation.example", "repeat.wait_interval"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_name", "repeat.count", "atlassian-operations_escalation.example", "repeat.count"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_id", "id", "atlassian-operations_escalation.example", "id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_id", "name", "atlassian-operations_escalation.example", "name"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_id", "team_id", "atlassian-operations_escalation.example", "team_id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_id", "description", "atlassian-operations_escalation.example", "description"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_id", "enabled", "atlassian-operations_escalation.example", "enabled"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_id", "rules.#", "atlassian-operations_escalation.example", "rules.#"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_id", "repeat.wait_interval", "atlassian-operations_escalation.example", "repeat.wait_interval"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.by_id", "repeat.count", "atlassian-operations_escalation.example", "repeat.count"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &EscalationListResource{}
+var _ list.ListResourceWithConfigure = &EscalationListResource{}
+
+func NewEscalationListResource() list.ListResource {
+	return &EscalationListResource{}
+}
+
+// EscalationListResource lists the escalations of a team.
+// The metadata, configuration and read logic are shared with EscalationResource.
+type EscalationListResource struct {
+	EscalationResource
+}
+
+func (r *EscalationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.TeamScopedListResourceAttributes,
+	}
+}
+
+func (r *EscalationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data dataModels.TeamScopedListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Trace(ctx, "Listing escalations")
+
+	escalations, err := fetchAllPages[dto.EscalationDto](r.clientConfiguration, fmt.Sprintf("/v1/teams/%s/escalations", data.TeamId.ValueString()), nil)
+	if err != nil {
+		stream.Results = listError(ctx, "Unable to list escalations", err)
+		return
+	}
+
+	listed := make([]listedResource, 0, len(escalations))
+	for _, item := range escalations {
+		listed = append(listed, listedResource{
+			displayName: item.Name,
+			identity: map[string]types.String{
+				"id":      types.StringValue(item.Id),
+				"team_id": data.TeamId,
+			},
+		})
+	}
+
+	stream.Results = listResults(ctx, req, &r.EscalationResource, listed)
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccEscalationListResource(t *testing.T) {
+	escalationName := uuid.NewString()
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	teamId := &testAccStateVariable{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		// List resources are only supported from Terraform 1.14 onwards
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the escalations to list
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_escalation" "first" {
+  name        = "` + escalationName + `-1"
+  description = "First escalation listed by Terraform"
+  team_id     = atlassian-operations_team.example.id
+  rules = [{
+    condition   = "if-not-acked"
+    notify_type = "default"
+    delay       = 5
+    recipient = {
+      id   = data.atlassian-operations_user.test1.account_id
+      type = "user"
+    }
+  }]
+}
+
+resource "atlassian-operations_escalation" "second" {
+  name    = "` + escalationName + `-2"
+  team_id = atlassian-operations_team.example.id
+  rules = [{
+    condition   = "if-not-closed"
+    notify_type = "default"
+    delay       = 10
+    recipient = {
+      id   = data.atlassian-operations_user.test1.account_id
+      type = "user"
+    }
+  }]
+}
+`,
+				Check: teamId.capture("atlassian-operations_team.example", "id"),
+			},
+			// List the escalations of the team along with their resource
+			{
+				Query: true,
+				Config: `
+variable "team_id" {
+  type = string
+}
+
+list "atlassian-operations_escalation" "test" {
+  provider         = atlassian-operations
+  include_resource = true
+
+  config {
+    team_id = var.team_id
+  }
+}
+`,
+				ConfigVariables: config.Variables{
+					"team_id": teamId,
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("atlassian-operations_escalation.test", 2),
+					querycheck.ExpectResourceKnownValues("atlassian-operations_escalation.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact(escalationName+"-1")), []querycheck.KnownValueCheck{
+							{Path: tfjsonpath.New("name"), KnownValue: knownvalue.StringExact(escalationName + "-1")},
+							{Path: tfjsonpath.New("description"), KnownValue: knownvalue.StringExact("First escalation listed by Terraform")},
+							{Path: tfjsonpath.New("rules"), KnownValue: knownvalue.SetSizeExact(1)},
+						}),
+					querycheck.ExpectResourceDisplayName("atlassian-operations_escalation.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact(escalationName+"-2")), knownvalue.StringExact(escalationName+"-2")),
+				},
+			},
+			// The number of results is limited
+			{
+				Query: true,
+				Config: `
+variable "team_id" {
+  type = string
+}
+
+list "atlassian-operations_escalation" "test" {
+  provider = atlassian-operations
+  limit    = 1
+
+  config {
+    team_id = var.team_id
+  }
+}
+`,
+				ConfigVariables: config.Variables{
+					"team_id": teamId,
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("atlassian-operations_escalation.test", 1),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// resolveRecipients sets the ID of the recipients of the escalation rules referenced by email or by name. When
+// unknownOnly is set, only the recipients whose ID is still unknown are resolved.
+func (r *EscalationResource) resolveRecipients(ctx context.Context, rules types.Set, unknownOnly bool) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if rules.IsNull() || rules.IsUnknown() {
+		return rules, diags
+	}
+
+	elements := rules.Elements()
+	resolved := make([]attr.Value, len(elements))
+	for i, element := range elements {
+		resolved[i] = element
+
+		ruleObject, ok := element.(types.Object)
+		if !ok || ruleObject.IsNull() || ruleObject.IsUnknown() {
+			continue
+		}
+		recipientObject, ok := ruleObject.Attributes()["recipient"].(types.Object)
+		if !ok || recipientObject.IsNull() || recipientObject.IsUnknown() {
+			continue
+		}
+
+		var recipient dataModels.EscalationRuleResourceRecipientModel
+		diags.Append(recipientObject.As(ctx, &recipient, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return rules, diags
+		}
+		if unknownOnly && !recipient.Id.IsUnknown() {
+			continue
+		}
+
+		var id, reference string
+		var err error
+		if !recipient.Email.IsNull() && !recipient.Email.IsUnknown() {
+			reference = recipient.Email.ValueString()
+			id, err = resolveUserId(r.clientConfiguration, reference)
+		} else if !recipient.Name.IsNull() && !recipient.Name.IsUnknown() && recipient.Type.ValueString() == "schedule" {
+			reference = recipient.Name.ValueString()
+			id, err = resolveScheduleId(r.clientConfiguration, reference)
+		} else if !recipient.Name.IsNull() && !recipient.Name.IsUnknown() && recipient.Type.ValueString() == "team" {
+			reference = recipient.Name.ValueString()
+			id, err = resolveTeamId(r.clientConfiguration, reference)
+		} else {
+			continue
+		}
+
+		if err != nil {
+			diags.AddAttributeError(path.Root("rules"), "Invalid Attribute",
+				fmt.Sprintf("Unable to resolve the %s recipient '%s', got error: %s", recipient.Type.ValueString(), reference, err))
+			continue
+		}
+		recipient.Id = types.StringValue(id)
+
+		attributes := make(map[string]attr.Value, len(ruleObject.Attributes()))
+		for name, value := range ruleObject.Attributes() {
+			attributes[name] = value
+		}
+		attributes["recipient"] = recipient.AsValue()
+
+		value, objectDiags := types.ObjectValue(dataModels.EscalationRuleResourceModelMap, attributes)
+		diags.Append(objectDiags...)
+		if objectDiags.HasError() {
+			return rules, diags
+		}
+		resolved[i] = value
+	}
+	if diags.HasError() {
+		return rules, diags
+	}
+
+	resolvedRules, setDiags := types.SetValue(rules.ElementType(ctx), resolved)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return rules, diags
+	}
+	return resolvedRules, diags
+}
+
+// escalationResourceRules converts the rules read from the API to the rules of the escalation resource, with the email
+// or name of the recipients of prior which have the same type and ID, as the API only returns the ID.
+func escalationResourceRules(ctx context.Context, rules types.Set, prior types.Set) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elementType := types.ObjectType{AttrTypes: dataModels.EscalationRuleResourceModelMap}
+	if rules.IsNull() {
+		return types.SetNull(elementType), diags
+	}
+
+	references := map[string]dataModels.EscalationRuleResourceRecipientModel{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorRules []dataModels.EscalationRuleResponseModel
+		diags.Append(prior.ElementsAs(ctx, &priorRules, false)...)
+		for _, rule := range priorRules {
+			var recipient dataModels.EscalationRuleResourceRecipientModel
+			diags.Append(rule.Recipient.As(ctx, &recipient, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})...)
+			references[recipient.Type.ValueString()+"/"+recipient.Id.ValueString()] = recipient
+		}
+	}
+
+	var apiRules []dataModels.EscalationRuleResponseModel
+	diags.Append(rules.ElementsAs(ctx, &apiRules, false)...)
+	if diags.HasError() {
+		return types.SetNull(elementType), diags
+	}
+
+	elements := make([]attr.Value, len(apiRules))
+	for i, rule := range apiRules {
+		var apiRecipient dataModels.EscalationRuleResponseRecipientModel
+		diags.Append(rule.Recipient.As(ctx, &apiRecipient, basetypes.ObjectAsOptions{})...)
+
+		recipient := dataModels.EscalationRuleResourceRecipientModel{
+			Id:    apiRecipient.Id,
+			Type:  apiRecipient.Type,
+			Email: types.StringNull(),
+			Name:  types.StringNull(),
+		}
+		if reference, ok := references[apiRecipient.Type.ValueString()+"/"+apiRecipient.Id.ValueString()]; ok {
+			recipient.Email = reference.Email
+			recipient.Name = reference.Name
+		}
+
+		elements[i] = types.ObjectValueMust(dataModels.EscalationRuleResourceModelMap, map[string]attr.Value{
+			"condition":   rule.Condition,
+			"notify_type": rule.NotifyType,
+			"delay":       rule.Delay,
+			"recipient":   recipient.AsValue(),
+		})
+	}
+	if diags.HasError() {
+		return types.SetNull(elementType), diags
+	}
+
+	resourceRules, setDiags := types.SetValue(elementType, elements)
+	diags.Append(setDiags...)
+	return resourceRules, diags
+}
+
+// resolveUserId returns the account ID of the user with the given email address. Users are searched through the Jira
+// API for the jira-service-desk product type, and through the organization directory otherwise, which needs the
+// organization_id of the provider configuration.
+func resolveUserId(clientConfiguration dto.AtlassianOpsProviderModel, email string) (string, error) {
+	var candidates []string
+	switch clientConfiguration.GetProductType() {
+	case "jira-service-desk":
+		var users []dto.UserDto
+		httpResp, err := httpClientHelpers.
+			GenerateUserClientRequest(clientConfiguration).
+			Method(httpClient.GET).
+			JoinBaseUrl("/search").
+			SetQueryParams(map[string]string{
+				"query": email,
+			}).
+			SetBodyParseObject(&users).
+			Send()
+		if err = userSearchError(httpResp, err); err != nil {
+			return "", err
+		}
+
+		for _, user := range users {
+			if strings.EqualFold(user.EmailAddress, email) {
+				candidates = append(candidates, user.AccountId)
+			}
+		}
+		// The email address of the users is hidden by their profile visibility settings
+		if len(candidates) == 0 && len(users) == 1 && users[0].EmailAddress == "" {
+			candidates = append(candidates, users[0].AccountId)
+		}
+	default:
+		if clientConfiguration.GetOrganizationId() == "" {
+			return "", fmt.Errorf("organization_id must be set in the provider configuration to look up users by email")
+		}
+
+		var searchResponseDto dto.OrgUserSearchResponseDto
+		httpResp, err := httpClientHelpers.
+			GenerateUserClientRequest(clientConfiguration).
+			Method(httpClient.GET).
+			JoinBaseUrl(fmt.Sprintf("%s/directories/-/users", clientConfiguration.GetOrganizationId())).
+			SetQueryParams(map[string]string{
+				"searchTerm": email,
+			}).
+			SetBodyParseObject(&searchResponseDto).
+			Send()
+		if err = userSearchError(httpResp, err); err != nil {
+			return "", err
+		}
+
+		for _, user := range searchResponseDto.Data {
+			if strings.EqualFold(user.Email, email) {
+				candidates = append(candidates, user.AccountId)
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("no user with the email address '%s' found", email)
+	} else if len(candidates) > 1 {
+		return "", fmt.Errorf("the email address '%s' is ambiguous, candidates: %s. Set the id of the recipient to select one of them", email, strings.Join(candidates, ", "))
+	}
+	return candidates[0], nil
+}
+
+func userSearchError(httpResp *httpClient.Response, err error) error {
+	if err != nil {
+		return err
+	} else if httpResp == nil {
+		return fmt.Errorf("got nil response while searching users")
+	} else if httpResp.IsError() {
+		statusCode := httpResp.GetStatusCode()
+		errorResponse := httpResp.GetErrorBody()
+		if errorResponse != nil {
+			return fmt.Errorf("error while searching users. Status Code: %d. Got response: %s", statusCode, *errorResponse)
+		}
+		return fmt.Errorf("error while searching users. Status Code: %d", statusCode)
+	}
+	return nil
+}
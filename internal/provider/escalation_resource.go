@@ -13,7 +13,9 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"strings"
 )
@@ -21,6 +23,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &EscalationResource{}
 var _ resource.ResourceWithImportState = &EscalationResource{}
+var _ resource.ResourceWithIdentity = &EscalationResource{}
+var _ resource.ResourceWithModifyPlan = &EscalationResource{}
 
 func NewEscalationResource() resource.Resource {
 	return &EscalationResource{}
@@ -41,6 +45,12 @@ func (r *EscalationResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *EscalationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.TeamScopedIdentityAttributes,
+	}
+}
+
 func (r *EscalationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring EscalationResource")
 
@@ -65,6 +75,26 @@ func (r *EscalationResource) Configure(ctx context.Context, req resource.Configu
 	tflog.Trace(ctx, "Configured EscalationResource")
 }
 
+// ModifyPlan resolves the IDs of the recipients referenced by email or by name, so that they are shown in the plan.
+func (r *EscalationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var rules types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rules"), &rules)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := r.resolveRecipients(ctx, rules, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rules"), rules)...)
+}
+
 func (r *EscalationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "Creating the EscalationResource")
 
@@ -72,6 +102,14 @@ func (r *EscalationResource) Create(ctx context.Context, req resource.CreateRequ
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	// Resolve the recipients whose email or name was not known at plan time
+	rules, diags := r.resolveRecipients(ctx, data.Rules, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Rules = rules
+
 	escalationDto := EscalationModelToDto(ctx, data)
 
 	httpResp, err := httpClientHelpers.
@@ -106,11 +144,14 @@ func (r *EscalationResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	data = EscalationDtoToModel(data.TeamId.ValueString(), escalationDto)
+	data.Rules, diags = escalationResourceRules(ctx, data.Rules, rules)
+	resp.Diagnostics.Append(diags...)
 
 	tflog.Trace(ctx, "Created the EscalationResource")
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the EscalationResource into Terraform state")
 }
 
@@ -153,11 +194,16 @@ func (r *EscalationResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	state := data
 	data = EscalationDtoToModel(data.TeamId.ValueString(), escalationDto)
+	rules, diags := escalationResourceRules(ctx, data.Rules, state.Rules)
+	resp.Diagnostics.Append(diags...)
+	data.Rules = rules
 
 	tflog.Trace(ctx, "Read the EscalationResource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the EscalationResource into Terraform state")
 }
 
@@ -169,6 +215,14 @@ func (r *EscalationResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	tflog.Trace(ctx, "Updating the EscalationResource")
 
+	// Resolve the recipients whose email or name was not known at plan time
+	rules, diags := r.resolveRecipients(ctx, data.Rules, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Rules = rules
+
 	escalationDto := EscalationModelToDto(ctx, data)
 
 	httpResp, err := httpClientHelpers.
@@ -203,6 +257,8 @@ func (r *EscalationResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	data = EscalationDtoToModel(data.TeamId.ValueString(), escalationDto)
+	data.Rules, diags = escalationResourceRules(ctx, data.Rules, rules)
+	resp.Diagnostics.Append(diags...)
 
 	tflog.Trace(ctx, "Updated the EscalationResource")
 
@@ -211,6 +267,7 @@ func (r *EscalationResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the EscalationResource into Terraform state")
 }
 
@@ -254,11 +311,32 @@ func (r *EscalationResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *EscalationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
+	if team, name, found := splitNameImportId(req.ID); found {
+		// Imported by name, the team being given by ID or by name
+		teamId, id, err := resolveTeamScopedName(r.clientConfiguration, "escalation", team, name, "/v1/teams/%s/escalations", nil, func(item dto.EscalationDto) (string, string) {
+			return item.Name, item.Id
+		})
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Unable to import escalation, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import escalation, got error: %s", err))
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamId)...)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: id,team_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: id,team_id; or: team/name (team being the ID or the name of the team). Got: %q", req.ID),
 		)
 		return
 	}
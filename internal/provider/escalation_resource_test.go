@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"os"
+	"regexp"
 	"testing"
 )
 
@@ -117,6 +118,18 @@ resource "atlassian-operations_escalation" "example" {
 						nil
 				},
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "atlassian-operations_escalation.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_escalation.example"].Primary.Attributes["team_id"] +
+							"/" +
+							state.RootModule().Resources["atlassian-operations_escalation.example"].Primary.Attributes["name"],
+						nil
+				},
+			},
 			// Update and Read testing
 			{
 				ExpectNonEmptyPlan: true,
@@ -313,3 +326,94 @@ resource "atlassian-operations_escalation" "example" {
 		},
 	})
 }
+
+func TestAccEscalationResource_RecipientReferences(t *testing.T) {
+	escalationName := uuid.NewString()
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// The notify_type must match the type of the recipient
+			{
+				Config:      providerConfig + testAccEscalationResourceRecipientReferencesConfig(escalationName, teamName, emailPrimary, organizationId, "next"),
+				ExpectError: regexp.MustCompile(`The\s+notify_type\s+'next'\s+can\s+only\s+be\s+used\s+with\s+recipients\s+of\s+type\s+schedule`),
+			},
+			// Create and Read testing, with the recipients resolved from the email of the user and the name of the team
+			{
+				Config: providerConfig + testAccEscalationResourceRecipientReferencesConfig(escalationName, teamName, emailPrimary, organizationId, "all"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_escalation.example", "rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("atlassian-operations_escalation.example", "rules.*", map[string]string{
+						"notify_type":     "default",
+						"recipient.type":  "user",
+						"recipient.email": emailPrimary,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("atlassian-operations_escalation.example", "rules.*", map[string]string{
+						"notify_type":    "all",
+						"recipient.type": "team",
+						"recipient.name": teamName,
+					}),
+					resource.TestCheckTypeSetElemAttrPair("atlassian-operations_escalation.example", "rules.*.recipient.id", "data.atlassian-operations_user.test1", "account_id"),
+					resource.TestCheckTypeSetElemAttrPair("atlassian-operations_escalation.example", "rules.*.recipient.id", "atlassian-operations_team.example", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEscalationResourceRecipientReferencesConfig(escalationName string, teamName string, emailPrimary string, organizationId string, teamNotifyType string) string {
+	return `
+data "atlassian-operations_user" "test1" {
+  email_address   = "` + emailPrimary + `"
+  organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description     = "This is a team created by Terraform"
+  display_name    = "` + teamName + `"
+  team_type       = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_escalation" "example" {
+  name    = "` + escalationName + `"
+  team_id = atlassian-operations_team.example.id
+  rules = [{
+    condition   = "if-not-acked"
+    notify_type = "default"
+    delay       = 5
+    recipient = {
+      email = "` + emailPrimary + `"
+      type  = "user"
+    }
+  },
+  {
+    condition   = "if-not-closed"
+    notify_type = "` + teamNotifyType + `"
+    delay       = 1
+    recipient = {
+      name = atlassian-operations_team.example.display_name
+      type = "team"
+    }
+  }]
+}
+`
+}
@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/integrationTypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// exportedResource is a remote object found by the exporter, along with its state read by the Read method of its
+// resource, or the diagnostics raised while listing or reading it.
+type exportedResource struct {
+	typeName    string
+	displayName string
+	schema      schema.Schema
+	identity    tftypes.Value
+	state       tftypes.Value
+	diags       diag.Diagnostics
+}
+
+// integrationResourceNames maps the integration types which have a dedicated resource to that resource.
+var integrationResourceNames = map[string]string{
+	"API":     "api_integration",
+	"Email":   "email_integration",
+	"Webhook": "webhook_integration",
+}
+
+// exporter discovers the objects of a site with the list resources of the provider, and reads them in the same way
+// as `terraform query` does.
+type exporter struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+	resources           []exportedResource
+}
+
+// Export reads the teams, schedules, rotations, escalations, routing rules, policies, heartbeats, maintenances,
+// integrations, integration actions and custom roles of the site the provider is configured for through its
+// environment variables, and writes the Terraform configuration of every object along with its import block.
+// The organization ID is required to list the teams of the site.
+func Export(ctx context.Context, version string, w io.Writer) error {
+	clientConfiguration, err := exportClientConfiguration(ctx, version)
+	if err != nil {
+		return err
+	}
+
+	if clientConfiguration.GetOrganizationId() == "" {
+		return errors.New("ATLASSIAN_OPS_ORGANIZATION_ID must be set to list the teams of the organization")
+	}
+
+	e := &exporter{clientConfiguration: clientConfiguration}
+	e.discover(ctx)
+	return e.write(w)
+}
+
+// exportClientConfiguration configures the provider with an empty configuration, so that the client configuration is
+// read from the environment variables.
+func exportClientConfiguration(ctx context.Context, version string) (dto.AtlassianOpsProviderModel, error) {
+	p := New(version)()
+
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	configureResp := provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    objectValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		return dto.AtlassianOpsProviderModel{}, diagnosticsError(configureResp.Diagnostics)
+	}
+
+	clientConfiguration, ok := configureResp.ResourceData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		return dto.AtlassianOpsProviderModel{}, fmt.Errorf("expected *JsmOpsClient, got: %T", configureResp.ResourceData)
+	}
+	return clientConfiguration, nil
+}
+
+// discover lists the objects of the site, reading the objects owned by teams and schedules once their owner is found.
+func (e *exporter) discover(ctx context.Context) {
+	cfg := e.clientConfiguration
+
+	teams := e.list(ctx, "team", &TeamListResource{TeamResource{clientConfiguration: cfg}}, map[string]string{"organization_id": cfg.GetOrganizationId()})
+	for _, team := range teams {
+		teamConfig := map[string]string{"team_id": stringAttribute(team.identity, "id")}
+
+		e.list(ctx, "escalation", &EscalationListResource{EscalationResource{clientConfiguration: cfg}}, teamConfig)
+		e.list(ctx, "routing_rule", &RoutingRuleListResource{RoutingRuleResource{clientConfiguration: cfg}}, teamConfig)
+		e.list(ctx, "alert_policy", &AlertPolicyListResource{AlertPolicyResource{clientConfiguration: cfg}}, teamConfig)
+		e.list(ctx, "notification_policy", &NotificationPolicyListResource{NotificationPolicyResource{clientConfiguration: cfg}}, teamConfig)
+		e.list(ctx, "heartbeat", &HeartbeatListResource{HeartbeatResource{clientConfiguration: cfg}}, teamConfig)
+		e.list(ctx, "maintenance", &MaintenanceListResource{MaintenanceResource{clientConfiguration: cfg}}, teamConfig)
+	}
+
+	// Global policies and maintenances are listed when no team is set
+	e.list(ctx, "alert_policy", &AlertPolicyListResource{AlertPolicyResource{clientConfiguration: cfg}}, nil)
+	e.list(ctx, "maintenance", &MaintenanceListResource{MaintenanceResource{clientConfiguration: cfg}}, nil)
+
+	schedules := e.list(ctx, "schedule", &ScheduleListResource{ScheduleResource{clientConfiguration: cfg}}, nil)
+	for _, schedule := range schedules {
+		e.list(ctx, "schedule_rotation", &ScheduleRotationListResource{ScheduleRotationResource{clientConfiguration: cfg}}, map[string]string{"schedule_id": stringAttribute(schedule.identity, "id")})
+	}
+
+	// Integrations are listed directly, as webhook integrations, integration actions and custom roles have no list
+	// resource. The integrations without a dedicated resource are exported with the generic integration resource.
+	integrations, err := fetchAllPages[dto.ApiIntegration](cfg, "v1/integrations", nil)
+	if err != nil {
+		e.failed("integration", "Unable to list integrations", err)
+	} else {
+		listed := make(map[string][]listedResource)
+		for _, item := range integrations {
+			name, ok := integrationResourceNames[item.Type]
+			if !ok {
+				if _, known := integrationTypes.Lookup(item.Type); !known {
+					e.skipped("integration", item.Name, fmt.Sprintf("The %s integration type is not supported by the provider", item.Type))
+					continue
+				}
+				name = "integration"
+			}
+			listed[name] = append(listed[name], listedResource{
+				displayName: item.Name,
+				identity:    map[string]types.String{"id": types.StringValue(item.Id)},
+			})
+		}
+		e.listed(ctx, "api_integration", &ApiIntegrationResource{clientConfiguration: cfg}, listed["api_integration"])
+		e.listed(ctx, "email_integration", &EmailIntegrationResource{clientConfiguration: cfg}, listed["email_integration"])
+		e.listed(ctx, "webhook_integration", &WebhookIntegrationResource{clientConfiguration: cfg}, listed["webhook_integration"])
+		e.listed(ctx, "integration", &IntegrationResource{clientConfiguration: cfg}, listed["integration"])
+
+		for _, integration := range integrations {
+			actions, err := fetchAllPages[dto.IntegrationActionDto](cfg, fmt.Sprintf("/v1/integrations/%s/actions", integration.Id), nil)
+			if err != nil {
+				e.failed("integration_action", fmt.Sprintf("Unable to list the actions of integration %s", integration.Name), err)
+				continue
+			}
+
+			listed := make([]listedResource, 0, len(actions))
+			for _, item := range actions {
+				listed = append(listed, listedResource{
+					displayName: fmt.Sprintf("%s %s", integration.Name, item.Name),
+					identity: map[string]types.String{
+						"id":             types.StringValue(item.ID),
+						"integration_id": types.StringValue(integration.Id),
+					},
+				})
+			}
+			e.listed(ctx, "integration_action", &IntegrationActionResource{clientConfiguration: cfg}, listed)
+		}
+	}
+
+	customRoles, err := fetchAllPages[dto.CustomRoleDto](cfg, "/v1/roles", nil)
+	if err != nil {
+		e.failed("custom_role", "Unable to list custom roles", err)
+	} else {
+		listed := make([]listedResource, 0, len(customRoles))
+		for _, item := range customRoles {
+			listed = append(listed, listedResource{
+				displayName: item.Name,
+				identity:    map[string]types.String{"id": types.StringValue(item.ID)},
+			})
+		}
+		e.listed(ctx, "custom_role", &CustomRoleResource{clientConfiguration: cfg}, listed)
+	}
+}
+
+// list runs a list resource of the provider with the given configuration, including the state of the listed objects.
+// The objects read successfully are returned.
+func (e *exporter) list(ctx context.Context, name string, listResource list.ListResource, config map[string]string) []exportedResource {
+	r, ok := listResource.(resource.Resource)
+	if !ok {
+		e.failed(name, "Unable to export", fmt.Errorf("%T does not implement the resource", listResource))
+		return nil
+	}
+
+	schemaResp := list.ListResourceSchemaResponse{}
+	listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+
+	req := e.listRequest(ctx, r)
+	req.Config = tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    objectValue(schemaResp.Schema.Type().TerraformType(ctx), config),
+	}
+
+	stream := list.ListResultsStream{}
+	listResource.List(ctx, req, &stream)
+	return e.collect(name, req, stream.Results)
+}
+
+// listed reads the given objects, found without a list resource, in the same way as the list resources do.
+func (e *exporter) listed(ctx context.Context, name string, r resource.Resource, listed []listedResource) []exportedResource {
+	req := e.listRequest(ctx, r)
+	return e.collect(name, req, listResults(ctx, req, r, listed))
+}
+
+func (e *exporter) listRequest(ctx context.Context, r resource.Resource) list.ListRequest {
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	identitySchemaResp := resource.IdentitySchemaResponse{}
+	if withIdentity, ok := r.(resource.ResourceWithIdentity); ok {
+		withIdentity.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+	}
+
+	return list.ListRequest{
+		IncludeResource:        true,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}
+}
+
+// collect records the results of a listing. Results without identity report an error of the listing itself.
+func (e *exporter) collect(name string, req list.ListRequest, results iter.Seq[list.ListResult]) []exportedResource {
+	resourceSchema, _ := req.ResourceSchema.(schema.Schema)
+
+	var exported []exportedResource
+	for result := range results {
+		item := exportedResource{
+			typeName:    "atlassian-operations_" + name,
+			displayName: result.DisplayName,
+			schema:      resourceSchema,
+			diags:       result.Diagnostics,
+		}
+		if result.Identity != nil {
+			item.identity = result.Identity.Raw
+		}
+		if result.Resource != nil {
+			item.state = result.Resource.Raw
+		}
+
+		e.resources = append(e.resources, item)
+		if !item.diags.HasError() && !item.state.IsNull() {
+			exported = append(exported, item)
+		}
+	}
+	return exported
+}
+
+// failed records an error raised while discovering the objects of a resource type.
+func (e *exporter) failed(name string, summary string, err error) {
+	var diags diag.Diagnostics
+	diags.AddError("Client Error", fmt.Sprintf("%s, got error: %s", summary, err))
+	e.resources = append(e.resources, exportedResource{
+		typeName: "atlassian-operations_" + name,
+		diags:    diags,
+	})
+}
+
+// skipped records an object which is not exported, along with the reason why.
+func (e *exporter) skipped(name string, displayName string, reason string) {
+	var diags diag.Diagnostics
+	diags.AddWarning("Not Exported", reason)
+	e.resources = append(e.resources, exportedResource{
+		typeName:    "atlassian-operations_" + name,
+		displayName: displayName,
+		diags:       diags,
+	})
+}
+
+// objectValue builds an object of the given type whose attributes are null, apart from the given string attributes.
+func objectValue(objectType tftypes.Type, values map[string]string) tftypes.Value {
+	attributeTypes := objectType.(tftypes.Object).AttributeTypes
+
+	attributes := make(map[string]tftypes.Value, len(attributeTypes))
+	for name, attributeType := range attributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = tftypes.NewValue(attributeType, value)
+		} else {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	return tftypes.NewValue(objectType, attributes)
+}
+
+// stringAttribute returns a string attribute of an object, or an empty string when it is not set.
+func stringAttribute(object tftypes.Value, name string) string {
+	var attributes map[string]tftypes.Value
+	if object.IsNull() || object.As(&attributes) != nil {
+		return ""
+	}
+
+	var value string
+	if attribute, ok := attributes[name]; !ok || attribute.IsNull() || attribute.As(&value) != nil {
+		return ""
+	}
+	return value
+}
+
+// diagnosticsError joins the errors of diagnostics into a single error.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// labelPattern matches the characters of display names which are not allowed in resource labels.
+var labelPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// write renders the exported objects as resource blocks, each preceded by the import block bringing the object under
+// management. Strings holding the ID of another exported object are replaced by a reference to that object.
+func (e *exporter) write(w io.Writer) error {
+	labels := make(map[string]bool)
+	addresses := make(map[string]hcl.Traversal)
+	resourceLabels := make([]string, len(e.resources))
+
+	for i, item := range e.resources {
+		if item.diags.HasError() || item.state.IsNull() {
+			continue
+		}
+
+		label := resourceLabel(item.displayName)
+		for suffix := 2; labels[item.typeName+"."+label]; suffix++ {
+			label = fmt.Sprintf("%s_%d", resourceLabel(item.displayName), suffix)
+		}
+		labels[item.typeName+"."+label] = true
+		resourceLabels[i] = label
+
+		if id := stringAttribute(item.state, "id"); id != "" {
+			addresses[id] = hcl.Traversal{
+				hcl.TraverseRoot{Name: item.typeName},
+				hcl.TraverseAttr{Name: label},
+				hcl.TraverseAttr{Name: "id"},
+			}
+		}
+	}
+
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+
+	for i, item := range e.resources {
+		subject := item.typeName
+		if item.displayName != "" {
+			subject = fmt.Sprintf("%s %q", item.typeName, item.displayName)
+		}
+		for _, d := range item.diags {
+			body.AppendUnstructuredTokens(commentTokens(fmt.Sprintf("%s: %s: %s", subject, d.Summary(), d.Detail())))
+		}
+		if item.diags.HasError() || item.state.IsNull() {
+			body.AppendNewline()
+			continue
+		}
+
+		importBlock := body.AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: item.typeName},
+			hcl.TraverseAttr{Name: resourceLabels[i]},
+		})
+		importBlock.Body().SetAttributeRaw("identity", valueTokens(item.identity, nil))
+		body.AppendNewline()
+
+		resourceBlock := body.AppendNewBlock("resource", []string{item.typeName, resourceLabels[i]})
+		var attributes map[string]tftypes.Value
+		if err := item.state.As(&attributes); err != nil {
+			return err
+		}
+		for _, name := range sortedKeys(attributes) {
+			if tokens, ok := attributeTokens(item.schema.Attributes[name], attributes[name], addresses); ok {
+				resourceBlock.Body().SetAttributeRaw(name, tokens)
+			}
+		}
+		body.AppendNewline()
+	}
+
+	_, err := file.WriteTo(w)
+	return err
+}
+
+// attributeTokens renders the value of an attribute which can be configured. Computed only and null attributes are
+// left out of the configuration.
+func attributeTokens(attribute schema.Attribute, value tftypes.Value, addresses map[string]hcl.Traversal) (hclwrite.Tokens, bool) {
+	if attribute == nil || value.IsNull() || !value.IsKnown() || !(attribute.IsRequired() || attribute.IsOptional()) {
+		return nil, false
+	}
+
+	nested, ok := attribute.(schema.NestedAttribute)
+	if !ok {
+		return valueTokens(value, addresses), true
+	}
+
+	nestedAttributes := make(map[string]schema.Attribute)
+	for name, nestedAttribute := range nested.GetNestedObject().GetAttributes() {
+		nestedAttributes[name] = nestedAttribute
+	}
+
+	switch value.Type().(type) {
+	case tftypes.List, tftypes.Set:
+		var elements []tftypes.Value
+		_ = value.As(&elements)
+
+		tokens := make([]hclwrite.Tokens, 0, len(elements))
+		for _, element := range elements {
+			tokens = append(tokens, nestedObjectTokens(nestedAttributes, element, addresses))
+		}
+		return hclwrite.TokensForTuple(tokens), true
+	case tftypes.Map:
+		var elements map[string]tftypes.Value
+		_ = value.As(&elements)
+
+		tokens := make([]hclwrite.ObjectAttrTokens, 0, len(elements))
+		for _, key := range sortedKeys(elements) {
+			tokens = append(tokens, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+				Value: nestedObjectTokens(nestedAttributes, elements[key], addresses),
+			})
+		}
+		return hclwrite.TokensForObject(tokens), true
+	default:
+		return nestedObjectTokens(nestedAttributes, value, addresses), true
+	}
+}
+
+// nestedObjectTokens renders the configurable attributes of a nested object.
+func nestedObjectTokens(attributes map[string]schema.Attribute, value tftypes.Value, addresses map[string]hcl.Traversal) hclwrite.Tokens {
+	var fields map[string]tftypes.Value
+	_ = value.As(&fields)
+
+	tokens := make([]hclwrite.ObjectAttrTokens, 0, len(fields))
+	for _, name := range sortedKeys(fields) {
+		if valueTokens, ok := attributeTokens(attributes[name], fields[name], addresses); ok {
+			tokens = append(tokens, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForIdentifier(name),
+				Value: valueTokens,
+			})
+		}
+	}
+	return hclwrite.TokensForObject(tokens)
+}
+
+// valueTokens renders a value, replacing the strings found in addresses with the matching reference.
+func valueTokens(value tftypes.Value, addresses map[string]hcl.Traversal) hclwrite.Tokens {
+	if value.IsNull() || !value.IsKnown() {
+		return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType))
+	}
+
+	switch value.Type().(type) {
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		var elements []tftypes.Value
+		_ = value.As(&elements)
+
+		tokens := make([]hclwrite.Tokens, 0, len(elements))
+		for _, element := range elements {
+			tokens = append(tokens, valueTokens(element, addresses))
+		}
+		return hclwrite.TokensForTuple(tokens)
+	case tftypes.Map, tftypes.Object:
+		var elements map[string]tftypes.Value
+		_ = value.As(&elements)
+
+		_, isObject := value.Type().(tftypes.Object)
+		tokens := make([]hclwrite.ObjectAttrTokens, 0, len(elements))
+		for _, key := range sortedKeys(elements) {
+			if isObject && elements[key].IsNull() {
+				continue
+			}
+
+			name := hclwrite.TokensForValue(cty.StringVal(key))
+			if isObject {
+				name = hclwrite.TokensForIdentifier(key)
+			}
+			tokens = append(tokens, hclwrite.ObjectAttrTokens{
+				Name:  name,
+				Value: valueTokens(elements[key], addresses),
+			})
+		}
+		return hclwrite.TokensForObject(tokens)
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		_ = value.As(&s)
+		if traversal, ok := addresses[s]; ok {
+			return hclwrite.TokensForTraversal(traversal)
+		}
+		return hclwrite.TokensForValue(cty.StringVal(s))
+	case value.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		_ = value.As(&n)
+		return hclwrite.TokensForValue(cty.NumberVal(n))
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		_ = value.As(&b)
+		return hclwrite.TokensForValue(cty.BoolVal(b))
+	}
+
+	return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType))
+}
+
+// resourceLabel turns a display name into a valid resource label.
+func resourceLabel(displayName string) string {
+	label := strings.Trim(labelPattern.ReplaceAllString(strings.ToLower(displayName), "_"), "_")
+	if label == "" {
+		return "unnamed"
+	} else if label[0] >= '0' && label[0] <= '9' {
+		return "_" + label
+	}
+	return label
+}
+
+func commentTokens(comment string) hclwrite.Tokens {
+	return hclwrite.Tokens{{
+		Type:  hclsyntax.TokenComment,
+		Bytes: []byte("# " + strings.ReplaceAll(comment, "\n", " ") + "\n"),
+	}}
+}
+
+func sortedKeys[T any](values map[string]T) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
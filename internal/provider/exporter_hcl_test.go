@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestResourceLabel(t *testing.T) {
+	testCases := map[string]string{
+		"My Team":           "my_team",
+		"my-team":           "my_team",
+		"  Ops / On-Call  ": "ops_on_call",
+		"24/7 Support":      "_24_7_support",
+		"Équipe":            "quipe",
+		"!!!":               "unnamed",
+		"":                  "unnamed",
+	}
+
+	for displayName, expected := range testCases {
+		if label := resourceLabel(displayName); label != expected {
+			t.Errorf("resourceLabel(%q): expected %q, got %q", displayName, expected, label)
+		}
+	}
+}
+
+func TestValueTokens(t *testing.T) {
+	addresses := map[string]hcl.Traversal{
+		"team-id": {
+			hcl.TraverseRoot{Name: "atlassian-operations_team"},
+			hcl.TraverseAttr{Name: "ops"},
+			hcl.TraverseAttr{Name: "id"},
+		},
+	}
+
+	recipientType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":   tftypes.String,
+		"name": tftypes.String,
+		"type": tftypes.String,
+	}}
+
+	testCases := []struct {
+		name     string
+		value    tftypes.Value
+		expected string
+	}{
+		{
+			name:     "string with the ID of an exported object",
+			value:    tftypes.NewValue(tftypes.String, "team-id"),
+			expected: "atlassian-operations_team.ops.id",
+		},
+		{
+			name:     "string with another value",
+			value:    tftypes.NewValue(tftypes.String, "other-id"),
+			expected: `"other-id"`,
+		},
+		{
+			name:     "number",
+			value:    tftypes.NewValue(tftypes.Number, 10),
+			expected: "10",
+		},
+		{
+			name:     "null",
+			value:    tftypes.NewValue(tftypes.String, nil),
+			expected: "null",
+		},
+		{
+			name: "object, leaving out its null attributes",
+			value: tftypes.NewValue(recipientType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "team-id"),
+				"name": tftypes.NewValue(tftypes.String, nil),
+				"type": tftypes.NewValue(tftypes.String, "team"),
+			}),
+			expected: "{ id = atlassian-operations_team.ops.id type = \"team\" }",
+		},
+		{
+			name: "list",
+			value: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "team-id"),
+				tftypes.NewValue(tftypes.String, "other-id"),
+			}),
+			expected: `[atlassian-operations_team.ops.id, "other-id"]`,
+		},
+		{
+			name: "map, with quoted keys",
+			value: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"owner": tftypes.NewValue(tftypes.String, "team-id"),
+			}),
+			expected: `{ "owner" = atlassian-operations_team.ops.id }`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			tokens := strings.Join(strings.Fields(string(valueTokens(testCase.value, addresses).Bytes())), " ")
+			if tokens != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, tokens)
+			}
+		})
+	}
+}
+
+func TestExporterWrite(t *testing.T) {
+	teamSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":           schema.StringAttribute{Computed: true},
+			"display_name": schema.StringAttribute{Required: true},
+			"parent_id":    schema.StringAttribute{Optional: true},
+		},
+	}
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":           tftypes.String,
+		"display_name": tftypes.String,
+		"parent_id":    tftypes.String,
+	}}
+	identityType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id": tftypes.String,
+	}}
+
+	team := func(id string, displayName string, parentId interface{}) exportedResource {
+		return exportedResource{
+			typeName:    "atlassian-operations_team",
+			displayName: displayName,
+			schema:      teamSchema,
+			identity: tftypes.NewValue(identityType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, id),
+			}),
+			state: tftypes.NewValue(stateType, map[string]tftypes.Value{
+				"id":           tftypes.NewValue(tftypes.String, id),
+				"display_name": tftypes.NewValue(tftypes.String, displayName),
+				"parent_id":    tftypes.NewValue(tftypes.String, parentId),
+			}),
+		}
+	}
+
+	e := &exporter{resources: []exportedResource{
+		team("team-1", "My Team", nil),
+		// Its label collides with the one of the first team
+		team("team-2", "my-team", "team-1"),
+	}}
+	e.failed("schedule", "Unable to list schedules", errors.New("forbidden"))
+
+	var warning diag.Diagnostics
+	warning.AddWarning("Not Exported", "The Custom integration type is not supported by the provider")
+	e.resources = append(e.resources, exportedResource{
+		typeName:    "atlassian-operations_integration",
+		displayName: "Legacy",
+		diags:       warning,
+	})
+
+	var output bytes.Buffer
+	if err := e.write(&output); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `import {
+  to = atlassian-operations_team.my_team
+  identity = {
+    id = "team-1"
+  }
+}
+
+resource "atlassian-operations_team" "my_team" {
+  display_name = "My Team"
+}
+
+import {
+  to = atlassian-operations_team.my_team_2
+  identity = {
+    id = "team-2"
+  }
+}
+
+resource "atlassian-operations_team" "my_team_2" {
+  display_name = "my-team"
+  parent_id    = atlassian-operations_team.my_team.id
+}
+
+# atlassian-operations_schedule: Client Error: Unable to list schedules, got error: forbidden
+
+# atlassian-operations_integration "Legacy": Not Exported: The Custom integration type is not supported by the provider
+
+`
+	if output.String() != expected {
+		t.Errorf("unexpected output, expected:\n%s\ngot:\n%s", expected, output.String())
+	}
+}
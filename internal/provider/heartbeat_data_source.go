@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &HeartbeatDataSource{}
+	_ datasource.DataSourceWithConfigure = &HeartbeatDataSource{}
+)
+
+func NewHeartbeatDataSource() datasource.DataSource {
+	return &HeartbeatDataSource{}
+}
+
+// HeartbeatDataSource defines the data source implementation.
+type HeartbeatDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *HeartbeatDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_heartbeat"
+}
+
+func (d *HeartbeatDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Heartbeat data source, exposing the status, expiry and last ping time of a heartbeat",
+		Attributes:          schemaAttributes.HeartbeatDataSourceAttributes,
+	}
+}
+
+func (d *HeartbeatDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring heartbeat_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure heartbeat_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured heartbeat_data_source")
+}
+
+func (d *HeartbeatDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.HeartbeatDataSourceModel
+
+	tflog.Trace(ctx, "Reading heartbeat data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read heartbeat configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	teamId := model.TeamID.ValueString()
+	name := model.Name.ValueString()
+
+	heartbeats, err := fetchAllPages[dto.HeartbeatDto](d.clientConfiguration, fmt.Sprintf("/v1/teams/%s/heartbeats", teamId), map[string]string{"name": name})
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read heartbeat, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read heartbeat or to parse received data, got error: %s", err))
+		return
+	}
+
+	var heartbeatDto *dto.HeartbeatDto
+	for _, hb := range heartbeats {
+		if hb.Name == name {
+			heartbeatDto = &hb
+			break
+		}
+	}
+
+	if heartbeatDto == nil {
+		tflog.Error(ctx, "No heartbeats found")
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("No heartbeat named '%s' found in team %s", name, teamId))
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP request to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
+	model, diags := HeartbeatDtoToDataSourceModel(ctx, heartbeatDto, teamId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Successfully read heartbeat data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHeartbeatDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	heartbeatName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + testAccHeartbeatDataSourceConfig(teamName, heartbeatName, emailPrimary, organizationId) + `
+data "atlassian-operations_heartbeat" "test" {
+	team_id = atlassian-operations_team.example.id
+	name    = atlassian-operations_heartbeat.example.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_heartbeat.test", "id", "atlassian-operations_heartbeat.example", "id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_heartbeat.test", "name", "atlassian-operations_heartbeat.example", "name"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_heartbeat.test", "team_id", "atlassian-operations_heartbeat.example", "team_id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_heartbeat.test", "description", "atlassian-operations_heartbeat.example", "description"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_heartbeat.test", "interval", "atlassian-operations_heartbeat.example", "interval"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_heartbeat.test", "interval_unit", "atlassian-operations_heartbeat.example", "interval_unit"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_heartbeat.test", "enabled", "atlassian-operations_heartbeat.example", "enabled"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_heartbeat.test", "alert_priority", "atlassian-operations_heartbeat.example", "alert_priority"),
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_heartbeat.test", "status"),
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_heartbeat.test", "expired"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHeartbeatDataSourceConfig(teamName string, heartbeatName string, emailPrimary string, organizationId string) string {
+	return `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_heartbeat" "example" {
+  name          = "` + heartbeatName + `"
+  description   = "Test heartbeat"
+  interval      = 5
+  interval_unit = "minutes"
+  enabled       = true
+  team_id       = atlassian-operations_team.example.id
+  alert_priority = "P2"
+}
+`
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &HeartbeatListResource{}
+var _ list.ListResourceWithConfigure = &HeartbeatListResource{}
+
+func NewHeartbeatListResource() list.ListResource {
+	return &HeartbeatListResource{}
+}
+
+// HeartbeatListResource lists the heartbeats of a team.
+// The metadata, configuration and read logic are shared with HeartbeatResource.
+type HeartbeatListResource struct {
+	HeartbeatResource
+}
+
+func (r *HeartbeatListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.TeamScopedListResourceAttributes,
+	}
+}
+
+func (r *HeartbeatListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data dataModels.TeamScopedListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Trace(ctx, "Listing heartbeats")
+
+	heartbeats, err := fetchAllPages[dto.HeartbeatDto](r.clientConfiguration, fmt.Sprintf("/v1/teams/%s/heartbeats", data.TeamId.ValueString()), nil)
+	if err != nil {
+		stream.Results = listError(ctx, "Unable to list heartbeats", err)
+		return
+	}
+
+	listed := make([]listedResource, 0, len(heartbeats))
+	for _, item := range heartbeats {
+		listed = append(listed, listedResource{
+			displayName: item.Name,
+			identity: map[string]types.String{
+				"id":      types.StringValue(item.Id),
+				"team_id": data.TeamId,
+			},
+		})
+	}
+
+	stream.Results = listResults(ctx, req, &r.HeartbeatResource, listed)
+}
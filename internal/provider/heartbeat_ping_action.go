@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.Action = &HeartbeatPingAction{}
+var _ action.ActionWithConfigure = &HeartbeatPingAction{}
+
+func NewHeartbeatPingAction() action.Action {
+	return &HeartbeatPingAction{}
+}
+
+// HeartbeatPingAction sends a ping to a heartbeat, e.g. at the end of a deployment.
+type HeartbeatPingAction struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (a *HeartbeatPingAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_heartbeat_ping"
+}
+
+func (a *HeartbeatPingAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sends a ping to a heartbeat of a team.",
+		Attributes:  schemaAttributes.HeartbeatPingActionAttributes,
+	}
+}
+
+func (a *HeartbeatPingAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	a.clientConfiguration = client
+}
+
+func (a *HeartbeatPingAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Trace(ctx, "Invoking the heartbeat_ping action")
+
+	var data dataModels.HeartbeatPingActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(a.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/heartbeats/ping", data.TeamId.ValueString())).
+		Method(httpClient.POST).
+		SetQueryParams(map[string]string{"name": data.Name.ValueString()}).
+		Send()
+
+	handleHttpResponse(httpResp, err, "ping heartbeat", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Pinged heartbeat %s of team %s", data.Name.ValueString(), data.TeamId.ValueString()),
+	})
+
+	tflog.Trace(ctx, "Invoked the heartbeat_ping action")
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccHeartbeatPingAction(t *testing.T) {
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		// Actions are only supported from Terraform 1.14 onwards
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invoke the action after the trigger is created
+			{
+				Config: providerConfig + testAccHeartbeatPingActionConfig(teamName, emailPrimary, organizationId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_heartbeat.test", "name", "test-heartbeat"),
+					resource.TestCheckResourceAttrPair("terraform_data.trigger", "output", "atlassian-operations_heartbeat.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHeartbeatPingActionConfig(teamName string, emailPrimary string, organizationId string) string {
+	return `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+  	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_heartbeat" "test" {
+  name          = "test-heartbeat"
+  interval      = 5
+  interval_unit = "minutes"
+  enabled       = true
+  team_id       = atlassian-operations_team.example.id
+}
+
+action "atlassian-operations_heartbeat_ping" "test" {
+  config {
+    team_id = atlassian-operations_heartbeat.test.team_id
+    name    = atlassian-operations_heartbeat.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = atlassian-operations_heartbeat.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.atlassian-operations_heartbeat_ping.test]
+    }
+  }
+}
+`
+}
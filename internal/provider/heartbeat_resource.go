@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
@@ -12,16 +13,39 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &HeartbeatResource{}
-	_ resource.ResourceWithConfigure   = &HeartbeatResource{}
-	_ resource.ResourceWithImportState = &HeartbeatResource{}
+	_ resource.Resource                   = &HeartbeatResource{}
+	_ resource.ResourceWithConfigure      = &HeartbeatResource{}
+	_ resource.ResourceWithImportState    = &HeartbeatResource{}
+	_ resource.ResourceWithIdentity       = &HeartbeatResource{}
+	_ resource.ResourceWithValidateConfig = &HeartbeatResource{}
+	_ resource.ResourceWithModifyPlan     = &HeartbeatResource{}
 )
 
+// The heartbeat API accepts intervals between 1 minute and 30 days
+const (
+	heartbeatMinInterval = time.Minute
+	heartbeatMaxInterval = 30 * 24 * time.Hour
+)
+
+type heartbeatIntervalUnit struct {
+	name     string
+	duration time.Duration
+}
+
+// heartbeatIntervalUnits are the units of heartbeat intervals, from the largest to the smallest.
+var heartbeatIntervalUnits = []heartbeatIntervalUnit{
+	{"days", 24 * time.Hour},
+	{"hours", time.Hour},
+	{"minutes", time.Minute},
+}
+
 type HeartbeatResource struct {
 	clientConfiguration dto.AtlassianOpsProviderModel
 }
@@ -41,6 +65,78 @@ func (r *HeartbeatResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *HeartbeatResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.TeamScopedIdentityAttributes,
+	}
+}
+
+func (r *HeartbeatResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.HeartbeatModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var interval time.Duration
+	var attributePath path.Path
+	if !data.Period.IsNull() {
+		if data.Period.IsUnknown() {
+			return
+		}
+
+		attributePath = path.Root("period")
+		value, _, err := heartbeatPeriod(data.Period.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(attributePath, "Invalid Attribute", err.Error())
+			return
+		}
+		interval = value
+	} else {
+		if data.Interval.IsNull() || data.Interval.IsUnknown() || data.IntervalUnit.IsNull() || data.IntervalUnit.IsUnknown() {
+			// Missing or invalid values are reported by the attribute validators
+			return
+		}
+
+		attributePath = path.Root("interval")
+		for _, unit := range heartbeatIntervalUnits {
+			if unit.name == data.IntervalUnit.ValueString() {
+				interval = time.Duration(data.Interval.ValueInt64()) * unit.duration
+			}
+		}
+		if interval == 0 {
+			return
+		}
+	}
+
+	if interval < heartbeatMinInterval || interval > heartbeatMaxInterval {
+		resp.Diagnostics.AddAttributeError(attributePath, "Invalid Attribute",
+			fmt.Sprintf("The heartbeat interval must be between 1 minute and 30 days, got %s", interval))
+	}
+}
+
+// ModifyPlan sets interval and interval_unit from period when the interval is configured as a duration.
+func (r *HeartbeatResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var period types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("period"), &period)...)
+	if resp.Diagnostics.HasError() || period.IsNull() || period.IsUnknown() {
+		return
+	}
+
+	interval, unit, err := heartbeatPeriod(period.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("period"), "Invalid Attribute", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("interval"), types.Int64Value(int64(interval/unit.duration)))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("interval_unit"), types.StringValue(unit.name))...)
+}
+
 func (r *HeartbeatResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring HeartbeatResource")
 
@@ -114,7 +210,13 @@ func (r *HeartbeatResource) Create(ctx context.Context, req resource.CreateReque
 	// Update state with response
 	result, diags := HeartbeatDtoToModel(ctx, heartbeatDto, data.TeamID.ValueString())
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The period is not returned by the API, it is kept as configured
+	result.Period = data.Period
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *HeartbeatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -126,47 +228,64 @@ func (r *HeartbeatResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	tflog.Trace(ctx, "Reading HeartbeatResource")
 
-	// Get heartbeats and find the one with the specified name
-	var heartbeatPaginatedResponseDto dto.HeartbeatPaginatedResponseDto
-	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
-		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/heartbeats", data.TeamID.ValueString())).
-		Method(httpClient.GET).
-		SetQueryParam("name", data.Name.ValueString()).
-		SetBodyParseObject(&heartbeatPaginatedResponseDto).
-		Send()
+	var heartbeatDto *dto.HeartbeatDto
+	if data.Id.ValueString() != "" {
+		// The heartbeat is looked up by its ID, as it may have been renamed outside of Terraform
+		heartbeats, err := fetchAllPages[dto.HeartbeatDto](r.clientConfiguration, fmt.Sprintf("/v1/teams/%s/heartbeats", data.TeamID.ValueString()), nil)
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to list heartbeats, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list heartbeats, got error: %s", err))
+			return
+		}
 
-	if httpResp == nil {
-		tflog.Error(ctx, "Client Error. Unable to read heartbeat, got nil response")
-		resp.Diagnostics.AddError("Client Error", "Unable to read heartbeat, got nil response")
-		return
-	}
+		for _, hb := range heartbeats {
+			if hb.Id == data.Id.ValueString() {
+				heartbeatDto = &hb
+				break
+			}
+		}
+	} else {
+		// The ID is not known yet when the heartbeat is imported by name, or for states written before the heartbeat
+		// was tracked by its ID
+		var heartbeatPaginatedResponseDto dto.HeartbeatPaginatedResponseDto
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(r.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/heartbeats", data.TeamID.ValueString())).
+			Method(httpClient.GET).
+			SetQueryParam("name", data.Name.ValueString()).
+			SetBodyParseObject(&heartbeatPaginatedResponseDto).
+			Send()
+
+		if httpResp == nil {
+			tflog.Error(ctx, "Client Error. Unable to read heartbeat, got nil response")
+			resp.Diagnostics.AddError("Client Error", "Unable to read heartbeat, got nil response")
+			return
+		}
 
-	if httpResp.IsError() {
-		statusCode := httpResp.GetStatusCode()
-		errorResponse := httpResp.GetErrorBody()
-		if errorResponse != nil {
-			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read heartbeat, status code: %d. Got response: %s", statusCode, *errorResponse))
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read heartbeat, status code: %d. Got response: %s", statusCode, *errorResponse))
-		} else {
-			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read heartbeat, got http response: %d", statusCode))
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read heartbeat, got http response: %d", statusCode))
+		if httpResp.IsError() {
+			statusCode := httpResp.GetStatusCode()
+			errorResponse := httpResp.GetErrorBody()
+			if errorResponse != nil {
+				tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read heartbeat, status code: %d. Got response: %s", statusCode, *errorResponse))
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read heartbeat, status code: %d. Got response: %s", statusCode, *errorResponse))
+			} else {
+				tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read heartbeat, got http response: %d", statusCode))
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read heartbeat, got http response: %d", statusCode))
+			}
+			return
 		}
-		return
-	}
 
-	if err != nil {
-		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read heartbeat, got error: %s", err))
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read heartbeat or to parse received data, got error: %s", err))
-		return
-	}
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read heartbeat, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read heartbeat or to parse received data, got error: %s", err))
+			return
+		}
 
-	// Find the heartbeat with the matching name
-	var heartbeatDto *dto.HeartbeatDto
-	for _, hb := range heartbeatPaginatedResponseDto.Values {
-		if hb.Name == data.Name.ValueString() {
-			heartbeatDto = &hb
-			break
+		for _, hb := range heartbeatPaginatedResponseDto.Values {
+			if hb.Name == data.Name.ValueString() {
+				heartbeatDto = &hb
+				break
+			}
 		}
 	}
 
@@ -177,12 +296,19 @@ func (r *HeartbeatResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	result, diags := HeartbeatDtoToModel(ctx, heartbeatDto, data.TeamID.ValueString())
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The period is not returned by the API, it is kept as configured
+	result.Period = data.Period
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *HeartbeatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data dataModels.HeartbeatModel
+	var data, state dataModels.HeartbeatModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -199,7 +325,8 @@ func (r *HeartbeatResource) Update(ctx context.Context, req resource.UpdateReque
 		GenerateJsmOpsClientRequest(r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/heartbeats", data.TeamID.ValueString())).
 		Method(httpClient.PATCH).
-		SetQueryParam("name", data.Name.ValueString()).
+		// The heartbeat is looked up by its current name, the new name is sent in the body when it is renamed
+		SetQueryParam("name", state.Name.ValueString()).
 		SetBody(heartbeatDto).
 		SetBodyParseObject(&heartbeatDto).
 		Send()
@@ -229,9 +356,19 @@ func (r *HeartbeatResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	if heartbeatDto.Id == "" {
+		heartbeatDto.Id = state.Id.ValueString()
+	}
+
 	result, diags := HeartbeatDtoToModel(ctx, heartbeatDto, data.TeamID.ValueString())
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The period is not returned by the API, it is kept as configured
+	result.Period = data.Period
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *HeartbeatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -275,14 +412,40 @@ func (r *HeartbeatResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *HeartbeatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: name,team_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: id,team_id or name,team_id. Got: %q", req.ID),
 		)
 		return
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	if uuidPattern.MatchString(idParts[0]) {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), idParts[1])...)
 }
+
+// heartbeatPeriod parses the period of a heartbeat, returning the matching interval along with the largest unit
+// dividing it.
+func heartbeatPeriod(period string) (time.Duration, heartbeatIntervalUnit, error) {
+	interval, err := time.ParseDuration(period)
+	if err != nil {
+		return 0, heartbeatIntervalUnit{}, fmt.Errorf("the period must be a duration such as '15m', '2h' or '48h', got: %s", period)
+	}
+
+	for _, unit := range heartbeatIntervalUnits {
+		if interval > 0 && interval%unit.duration == 0 {
+			return interval, unit, nil
+		}
+	}
+	return 0, heartbeatIntervalUnit{}, fmt.Errorf("the period must be a positive whole number of minutes, got: %s", period)
+}
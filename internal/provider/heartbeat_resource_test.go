@@ -1,9 +1,20 @@
 package provider
 
 import (
+	"fmt"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -74,6 +85,197 @@ func TestAccHeartbeatResource(t *testing.T) {
 	})
 }
 
+func TestAccHeartbeatResource_rename(t *testing.T) {
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	sameId := statecheck.CompareValue(compare.ValuesSame())
+	var teamId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccHeartbeatResourceConfig(teamName, emailPrimary, organizationId),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameId.AddStateValue("atlassian-operations_heartbeat.test", tfjsonpath.New("id")),
+				},
+			},
+			// Rename in place, keeping the ID of the heartbeat
+			{
+				Config: providerConfig + testAccHeartbeatResourceRenamedConfig(teamName, emailPrimary, organizationId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("atlassian-operations_heartbeat.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_heartbeat.test", "name", "test-heartbeat-renamed"),
+					resource.TestCheckResourceAttr("atlassian-operations_heartbeat.test", "description", "Test heartbeat"),
+					func(state *terraform.State) error {
+						teamId = state.RootModule().Resources["atlassian-operations_heartbeat.test"].Primary.Attributes["team_id"]
+						return nil
+					},
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameId.AddStateValue("atlassian-operations_heartbeat.test", tfjsonpath.New("id")),
+				},
+			},
+			// Rename outside of Terraform, the heartbeat is still found by its ID and renamed back
+			{
+				PreConfig: func() {
+					testAccRenameHeartbeat(t, teamId, "test-heartbeat-renamed", "test-heartbeat-renamed-outside")
+				},
+				Config: providerConfig + testAccHeartbeatResourceRenamedConfig(teamName, emailPrimary, organizationId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("atlassian-operations_heartbeat.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_heartbeat.test", "name", "test-heartbeat-renamed"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameId.AddStateValue("atlassian-operations_heartbeat.test", tfjsonpath.New("id")),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHeartbeatResource_period(t *testing.T) {
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid units and intervals are rejected at plan time
+			{
+				Config:      providerConfig + testAccHeartbeatResourcePeriodConfig(teamName, emailPrimary, organizationId, `interval = 5`+"\n"+`interval_unit = "minute"`),
+				ExpectError: regexp.MustCompile(`(?s)value must be one of.*"minutes"`),
+			},
+			{
+				Config:      providerConfig + testAccHeartbeatResourcePeriodConfig(teamName, emailPrimary, organizationId, `period = "90s"`),
+				ExpectError: regexp.MustCompile(`whole\s+number\s+of\s+minutes`),
+			},
+			{
+				Config:      providerConfig + testAccHeartbeatResourcePeriodConfig(teamName, emailPrimary, organizationId, `interval = 31`+"\n"+`interval_unit = "days"`),
+				ExpectError: regexp.MustCompile(`between\s+1\s+minute\s+and\s+30\s+days`),
+			},
+			// Create with a period normalized into interval and interval_unit
+			{
+				Config: providerConfig + testAccHeartbeatResourcePeriodConfig(teamName, emailPrimary, organizationId, `period = "48h"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_heartbeat.test", "period", "48h"),
+					resource.TestCheckResourceAttr("atlassian-operations_heartbeat.test", "interval", "2"),
+					resource.TestCheckResourceAttr("atlassian-operations_heartbeat.test", "interval_unit", "days"),
+				),
+			},
+			// Update the period
+			{
+				Config: providerConfig + testAccHeartbeatResourcePeriodConfig(teamName, emailPrimary, organizationId, `period = "90m"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_heartbeat.test", "period", "90m"),
+					resource.TestCheckResourceAttr("atlassian-operations_heartbeat.test", "interval", "90"),
+					resource.TestCheckResourceAttr("atlassian-operations_heartbeat.test", "interval_unit", "minutes"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHeartbeatResource_identity(t *testing.T) {
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		// Resource identities are only supported from Terraform 1.12 onwards
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and check the identity
+			{
+				Config: providerConfig + testAccHeartbeatResourceConfig(teamName, emailPrimary, organizationId),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("atlassian-operations_heartbeat.test", map[string]knownvalue.Check{
+						"id":      knownvalue.NotNull(),
+						"team_id": knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesState("atlassian-operations_heartbeat.test", tfjsonpath.New("id")),
+					statecheck.ExpectIdentityValueMatchesState("atlassian-operations_heartbeat.test", tfjsonpath.New("team_id")),
+				},
+			},
+			// Import by identity
+			{
+				ResourceName:    "atlassian-operations_heartbeat.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+// testAccRenameHeartbeat renames a heartbeat through the API, outside of Terraform.
+func testAccRenameHeartbeat(t *testing.T, teamId string, name string, newName string) {
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(testAccClientConfiguration()).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/heartbeats", teamId)).
+		Method(httpClient.PATCH).
+		SetQueryParam("name", name).
+		SetBody(dto.HeartbeatDto{
+			Name:          newName,
+			Description:   "Test heartbeat",
+			Interval:      5,
+			IntervalUnit:  "minutes",
+			Enabled:       true,
+			AlertMessage:  "Service heartbeat missed",
+			AlertTags:     []string{"critical", "service"},
+			AlertPriority: "P2",
+		}).
+		Send()
+	if err != nil {
+		t.Fatalf("unable to rename the heartbeat %s, got error: %s", name, err)
+	} else if httpResp == nil || httpResp.IsError() {
+		t.Fatalf("unable to rename the heartbeat %s", name)
+	}
+}
+
 func testAccHeartbeatResourceConfig(teamName string, emailPrimary string, organizationId string) string {
 	return `
 data "atlassian-operations_user" "test1" {
@@ -139,3 +341,64 @@ resource "atlassian-operations_heartbeat" "test" {
 }
 `
 }
+
+func testAccHeartbeatResourceRenamedConfig(teamName string, emailPrimary string, organizationId string) string {
+	return `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+  	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_heartbeat" "test" {
+  name          = "test-heartbeat-renamed"
+  description   = "Test heartbeat"
+  interval      = 5
+  interval_unit = "minutes"
+  enabled       = true
+  team_id       = atlassian-operations_team.example.id
+  alert_message = "Service heartbeat missed"
+  alert_tags    = ["critical", "service"]
+  alert_priority = "P2"
+}
+`
+}
+
+func testAccHeartbeatResourcePeriodConfig(teamName string, emailPrimary string, organizationId string, interval string) string {
+	return `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+  	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_heartbeat" "test" {
+  name          = "test-heartbeat-period"
+  enabled       = true
+  team_id       = atlassian-operations_team.example.id
+  ` + interval + `
+}
+`
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHeartbeatsDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	heartbeatName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + testAccHeartbeatDataSourceConfig(teamName, heartbeatName, emailPrimary, organizationId) + `
+data "atlassian-operations_heartbeats" "test" {
+	depends_on = [atlassian-operations_heartbeat.example]
+	team_id    = atlassian-operations_team.example.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.atlassian-operations_heartbeats.test", "heartbeats.#", "1"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_heartbeats.test", "heartbeats.0.name", "atlassian-operations_heartbeat.example", "name"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_heartbeats.test", "heartbeats.0.team_id", "atlassian-operations_heartbeat.example", "team_id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_heartbeats.test", "heartbeats.0.interval", "atlassian-operations_heartbeat.example", "interval"),
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_heartbeats.test", "heartbeats.0.status"),
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_heartbeats.test", "heartbeats.0.expired"),
+				),
+			},
+		},
+	})
+}
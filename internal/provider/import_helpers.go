@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+)
+
+// uuidPattern matches the identifiers of the objects of the JSM Ops and Teams APIs, which are told apart from names
+// with it when importing a resource by name.
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// splitNameImportId splits an import identifier of the form "scope/name", where the scope is the ID or the name of
+// the team (or schedule) owning the object, or empty for objects which are not owned by a team. The name may contain
+// slashes, the scope may not.
+func splitNameImportId(id string) (string, string, bool) {
+	scope, name, found := strings.Cut(id, "/")
+	return scope, name, found && name != ""
+}
+
+// resolveTeamId returns the ID of a team given by ID or by name. Teams are looked up by name through the Teams API,
+// which needs the organization_id of the provider configuration.
+func resolveTeamId(clientConfiguration dto.AtlassianOpsProviderModel, team string) (string, error) {
+	if team == "" {
+		return "", fmt.Errorf("the team owning the object must be given by ID or by name")
+	} else if uuidPattern.MatchString(team) {
+		return team, nil
+	}
+
+	if clientConfiguration.GetOrganizationId() == "" {
+		return "", fmt.Errorf("'%s' is not a team ID, organization_id must be set in the provider configuration to look up teams by name", team)
+	}
+
+	teams, err := fetchTeams(clientConfiguration, clientConfiguration.GetOrganizationId())
+	if err != nil {
+		return "", err
+	}
+
+	return resolveName("team", team, teams, func(item dto.TeamDto) (string, string) {
+		return item.DisplayName, item.TeamId
+	})
+}
+
+// resolveScheduleId returns the ID of a schedule given by ID or by name.
+func resolveScheduleId(clientConfiguration dto.AtlassianOpsProviderModel, schedule string) (string, error) {
+	if uuidPattern.MatchString(schedule) {
+		return schedule, nil
+	}
+
+	return lookupIdByName(clientConfiguration, "schedule", "/v1/schedules", nil, schedule, func(item dto.Schedule) (string, string) {
+		return item.Name, item.Id
+	})
+}
+
+// resolveTeamScopedName returns the IDs of the team, given by ID or by name, and of the object of that team with the
+// given name. The objects of the team are listed from urlFormat, formatted with the team ID.
+func resolveTeamScopedName[T any](clientConfiguration dto.AtlassianOpsProviderModel, kind string, team string, name string, urlFormat string, queryParams map[string]string, nameAndId func(T) (string, string)) (string, string, error) {
+	teamId, err := resolveTeamId(clientConfiguration, team)
+	if err != nil {
+		return "", "", err
+	}
+
+	id, err := lookupIdByName(clientConfiguration, kind, fmt.Sprintf(urlFormat, teamId), queryParams, name, nameAndId)
+	if err != nil {
+		return "", "", err
+	}
+
+	return teamId, id, nil
+}
+
+// lookupIdByName lists the objects of a JSM Ops list endpoint and returns the ID of the only one with the given name.
+func lookupIdByName[T any](clientConfiguration dto.AtlassianOpsProviderModel, kind string, url string, queryParams map[string]string, name string, nameAndId func(T) (string, string)) (string, error) {
+	values, err := fetchAllPages[T](clientConfiguration, url, queryParams)
+	if err != nil {
+		return "", err
+	}
+
+	return resolveName(kind, name, values, nameAndId)
+}
+
+// resolveName returns the ID of the only value with the given name, failing with the list of candidate IDs when
+// several values share the name.
+func resolveName[T any](kind string, name string, values []T, nameAndId func(T) (string, string)) (string, error) {
+	var candidates []string
+	for _, value := range values {
+		valueName, id := nameAndId(value)
+		if valueName == name {
+			candidates = append(candidates, id)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("no %s named '%s' found", kind, name)
+	} else if len(candidates) > 1 {
+		return "", fmt.Errorf("the %s name '%s' is ambiguous, candidates: %s. Import by ID to select one of them", kind, name, strings.Join(candidates, ", "))
+	}
+
+	return candidates[0], nil
+}
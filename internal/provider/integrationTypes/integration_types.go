@@ -0,0 +1,234 @@
+package integrationTypes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	DirectionIncoming = "incoming"
+	DirectionOutgoing = "outgoing"
+)
+
+type PropertyKind string
+
+const (
+	StringProperty PropertyKind = "string"
+	BoolProperty   PropertyKind = "bool"
+	NumberProperty PropertyKind = "number"
+	ListProperty   PropertyKind = "list"
+	ObjectProperty PropertyKind = "object"
+)
+
+type (
+	Property struct {
+		Kind     PropertyKind
+		Required bool
+	}
+
+	IntegrationType struct {
+		Name       string
+		Directions []string
+		Properties map[string]Property
+	}
+)
+
+var incomingProperties = map[string]Property{
+	"suppressNotifications": {Kind: BoolProperty},
+}
+
+var outgoingWebhookProperties = map[string]Property{
+	"url":                 {Kind: StringProperty, Required: true},
+	"headers":             {Kind: ObjectProperty},
+	"addAlertDescription": {Kind: BoolProperty},
+	"addAlertDetails":     {Kind: BoolProperty},
+	"alertFilter":         {Kind: ObjectProperty},
+}
+
+// Registry holds the integration types known to the provider, keyed by the type name used by the JSM Ops API.
+var Registry = map[string]IntegrationType{
+	"API": {
+		Name:       "API",
+		Directions: []string{DirectionIncoming},
+		Properties: incomingProperties,
+	},
+	"Email": {
+		Name:       "Email",
+		Directions: []string{DirectionIncoming},
+		Properties: map[string]Property{
+			"emailUsername":         {Kind: StringProperty, Required: true},
+			"suppressNotifications": {Kind: BoolProperty},
+		},
+	},
+	"Webhook": {
+		Name:       "Webhook",
+		Directions: []string{DirectionOutgoing},
+		Properties: outgoingWebhookProperties,
+	},
+	"Datadog": {
+		Name:       "Datadog",
+		Directions: []string{DirectionIncoming, DirectionOutgoing},
+		Properties: incomingProperties,
+	},
+	"Prometheus": {
+		Name:       "Prometheus",
+		Directions: []string{DirectionIncoming},
+		Properties: incomingProperties,
+	},
+	"AmazonCloudWatch": {
+		Name:       "AmazonCloudWatch",
+		Directions: []string{DirectionIncoming},
+		Properties: incomingProperties,
+	},
+	"AmazonCloudWatchEvents": {
+		Name:       "AmazonCloudWatchEvents",
+		Directions: []string{DirectionIncoming},
+		Properties: incomingProperties,
+	},
+	"AmazonSecurityHub": {
+		Name:       "AmazonSecurityHub",
+		Directions: []string{DirectionIncoming},
+		Properties: map[string]Property{
+			"region":                {Kind: StringProperty},
+			"securityHubIamRoleArn": {Kind: StringProperty},
+			"suppressNotifications": {Kind: BoolProperty},
+		},
+	},
+	"AmazonSns": {
+		Name:       "AmazonSns",
+		Directions: []string{DirectionIncoming},
+		Properties: incomingProperties,
+	},
+	"AzureMonitor": {
+		Name:       "AzureMonitor",
+		Directions: []string{DirectionIncoming},
+		Properties: incomingProperties,
+	},
+	"GoogleStackdriver": {
+		Name:       "GoogleStackdriver",
+		Directions: []string{DirectionIncoming},
+		Properties: incomingProperties,
+	},
+	"Grafana": {
+		Name:       "Grafana",
+		Directions: []string{DirectionIncoming},
+		Properties: incomingProperties,
+	},
+	"NewRelicV2": {
+		Name:       "NewRelicV2",
+		Directions: []string{DirectionIncoming},
+		Properties: incomingProperties,
+	},
+	"Dynatrace": {
+		Name:       "Dynatrace",
+		Directions: []string{DirectionIncoming},
+		Properties: incomingProperties,
+	},
+	"Splunk": {
+		Name:       "Splunk",
+		Directions: []string{DirectionIncoming},
+		Properties: incomingProperties,
+	},
+	"Sentry": {
+		Name:       "Sentry",
+		Directions: []string{DirectionIncoming},
+		Properties: incomingProperties,
+	},
+	"Zabbix": {
+		Name:       "Zabbix",
+		Directions: []string{DirectionIncoming, DirectionOutgoing},
+		Properties: incomingProperties,
+	},
+	"Nagios": {
+		Name:       "Nagios",
+		Directions: []string{DirectionIncoming, DirectionOutgoing},
+		Properties: incomingProperties,
+	},
+	"Pingdom": {
+		Name:       "Pingdom",
+		Directions: []string{DirectionIncoming},
+		Properties: incomingProperties,
+	},
+	"StatusCake": {
+		Name:       "StatusCake",
+		Directions: []string{DirectionIncoming},
+		Properties: incomingProperties,
+	},
+}
+
+// Names returns the sorted names of all known integration types.
+func Names() []string {
+	names := make([]string, 0, len(Registry))
+	for name := range Registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func Lookup(name string) (IntegrationType, bool) {
+	integrationType, ok := Registry[name]
+	return integrationType, ok
+}
+
+func (t IntegrationType) SupportsDirection(direction string) bool {
+	for _, d := range t.Directions {
+		if d == direction {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateProperties checks the given type specific properties against the registry entry.
+// Errors are returned for missing required properties and properties of the wrong kind,
+// warnings for properties the registry does not know about.
+func (t IntegrationType) ValidateProperties(properties map[string]interface{}) (errs []string, warnings []string) {
+	for _, key := range sortedKeys(t.Properties) {
+		if _, ok := properties[key]; !ok && t.Properties[key].Required {
+			errs = append(errs, fmt.Sprintf("property '%s' is required for %s integrations", key, t.Name))
+		}
+	}
+
+	for _, key := range sortedKeys(properties) {
+		property, ok := t.Properties[key]
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("property '%s' is not known for %s integrations, known properties are: %s", key, t.Name, strings.Join(sortedKeys(t.Properties), ", ")))
+			continue
+		}
+		if !property.Kind.matches(properties[key]) {
+			errs = append(errs, fmt.Sprintf("property '%s' of %s integrations must be of type %s", key, t.Name, property.Kind))
+		}
+	}
+
+	return errs, warnings
+}
+
+func (k PropertyKind) matches(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	switch value.(type) {
+	case string:
+		return k == StringProperty
+	case bool:
+		return k == BoolProperty
+	case float64:
+		return k == NumberProperty
+	case []interface{}:
+		return k == ListProperty
+	case map[string]interface{}:
+		return k == ObjectProperty
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
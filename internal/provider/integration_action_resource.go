@@ -12,6 +12,7 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,6 +20,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &IntegrationActionResource{}
 var _ resource.ResourceWithImportState = &IntegrationActionResource{}
+var _ resource.ResourceWithIdentity = &IntegrationActionResource{}
 
 func NewIntegrationActionResource() resource.Resource {
 	return &IntegrationActionResource{}
@@ -39,6 +41,12 @@ func (r *IntegrationActionResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+func (r *IntegrationActionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.IntegrationActionIdentityAttributes,
+	}
+}
+
 func (r *IntegrationActionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring IntegrationActionResource")
 
@@ -117,6 +125,7 @@ func (r *IntegrationActionResource) Create(ctx context.Context, req resource.Cre
 	}
 	data = *modelPtr
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *IntegrationActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -168,6 +177,7 @@ func (r *IntegrationActionResource) Read(ctx context.Context, req resource.ReadR
 	}
 	data = *modelPtr
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *IntegrationActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -225,6 +235,7 @@ func (r *IntegrationActionResource) Update(ctx context.Context, req resource.Upd
 	}
 	data = *modelPtr
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *IntegrationActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -268,6 +279,12 @@ func (r *IntegrationActionResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IntegrationActionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
@@ -169,6 +169,13 @@ resource "atlassian-operations_integration_action" "example" {
 						nil
 				},
 			},
+			// Import by identity, enabled and group_type are not read back from the API so an update is planned
+			{
+				ResourceName:       "atlassian-operations_integration_action.example",
+				ImportState:        true,
+				ImportStateKind:    resource.ImportBlockWithResourceIdentity,
+				ExpectNonEmptyPlan: true,
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &IntegrationDataSource{}
+	_ datasource.DataSourceWithConfigure = &IntegrationDataSource{}
+)
+
+func NewIntegrationDataSource() datasource.DataSource {
+	return &IntegrationDataSource{}
+}
+
+// IntegrationDataSource defines the data source implementation.
+type IntegrationDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *IntegrationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (d *IntegrationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Integration data source",
+		Attributes:          schemaAttributes.IntegrationDataSourceAttributes,
+	}
+}
+
+func (d *IntegrationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring integration_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure integration_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured integration_data_source")
+}
+
+func (d *IntegrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.IntegrationDataSourceModel
+
+	tflog.Trace(ctx, "Reading integration data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read integration configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	integrations, err := fetchAllPages[dto.ApiIntegration](d.clientConfiguration, "v1/integrations", nil)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to list integrations, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list integrations, got error: %s", err))
+		return
+	}
+
+	var candidates []string
+	for _, integration := range integrations {
+		if integration.Name != model.Name.ValueString() || !strings.EqualFold(integration.Type, model.Type.ValueString()) {
+			continue
+		}
+		if !model.TeamId.IsNull() && integration.TeamId != model.TeamId.ValueString() {
+			continue
+		}
+		candidates = append(candidates, integration.Id)
+	}
+
+	if len(candidates) == 0 {
+		tflog.Error(ctx, "No integrations found")
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("No integration of type '%s' named '%s' found", model.Type.ValueString(), model.Name.ValueString()))
+		return
+	} else if len(candidates) > 1 {
+		tflog.Error(ctx, "Multiple integrations found")
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Multiple integrations of type '%s' named '%s' found: %s. Set team_id to narrow down the lookup.", model.Type.ValueString(), model.Name.ValueString(), strings.Join(candidates, ", ")))
+		return
+	}
+
+	tflog.Trace(ctx, "Sending HTTP request to JSM OPS API")
+
+	integration := dto.ApiIntegration{}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(d.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", candidates[0])).
+		Method(httpClient.GET).
+		SetBodyParseObject(&integration).
+		Send()
+
+	if httpResp == nil {
+		tflog.Error(ctx, "Client Error. Unable to read integration, got nil response")
+		resp.Diagnostics.AddError("Client Error", "Unable to read integration, got nil response")
+	} else if httpResp.IsError() {
+		statusCode := httpResp.GetStatusCode()
+		errorResponse := httpResp.GetErrorBody()
+		if errorResponse != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read integration, status code: %d. Got response: %s", statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration, status code: %d. Got response: %s", statusCode, *errorResponse))
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read integration, got http response: %d", statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration, got http response: %d", statusCode))
+		}
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read integration, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration or to parse received data, got error: %s", err))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP request to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
+	model = IntegrationDataSourceDtoToModel(integration, model.IncludeApiKey)
+
+	if model.IncludeApiKey.ValueBool() && model.ApiKey.IsNull() {
+		resp.Diagnostics.AddWarning("API key not available",
+			fmt.Sprintf("The API key of integration %s was requested but not returned by the API.", integration.Id))
+	}
+
+	tflog.Trace(ctx, "Successfully read integration data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
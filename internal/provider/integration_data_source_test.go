@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIntegrationDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	apiIntegrationName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_api_integration" "example" {
+  name    = "` + apiIntegrationName + `"
+  team_id = atlassian-operations_team.example.id
+  type = "API"
+  enabled = true
+}
+
+data "atlassian-operations_integration" "test" {
+	depends_on = ["atlassian-operations_api_integration.example"]
+	name = "` + apiIntegrationName + `"
+	type = "api"
+	team_id = atlassian-operations_team.example.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_integration.test", "id", "atlassian-operations_api_integration.example", "id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_integration.test", "name", "atlassian-operations_api_integration.example", "name"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_integration.test", "type", "atlassian-operations_api_integration.example", "type"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_integration.test", "team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_integration.test", "enabled", "atlassian-operations_api_integration.example", "enabled"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_integration.test", "type_specific_properties", "atlassian-operations_api_integration.example", "type_specific_properties"),
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_integration.test", "directions.#"),
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_integration.test", "domains.#"),
+					resource.TestCheckNoResourceAttr("data.atlassian-operations_integration.test", "api_key"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/integrationTypes"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IntegrationResource{}
+var _ resource.ResourceWithImportState = &IntegrationResource{}
+var _ resource.ResourceWithIdentity = &IntegrationResource{}
+var _ resource.ResourceWithValidateConfig = &IntegrationResource{}
+
+func NewIntegrationResource() resource.Resource {
+	return &IntegrationResource{}
+}
+
+// IntegrationResource defines the resource implementation.
+type IntegrationResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (r *IntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (r *IntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.IntegrationResourceAttributes,
+	}
+}
+
+func (r *IntegrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.IdIdentityAttributes,
+	}
+}
+
+func (r *IntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.ApiIntegrationModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Type.IsNull() || data.Type.IsUnknown() {
+		return
+	}
+
+	integrationType, ok := integrationTypes.Lookup(data.Type.ValueString())
+	if !ok {
+		// Unknown types are reported by the attribute validator of the type field
+		return
+	}
+
+	if !data.Directions.IsNull() && !data.Directions.IsUnknown() {
+		directions := make([]types.String, 0, len(data.Directions.Elements()))
+		resp.Diagnostics.Append(data.Directions.ElementsAs(ctx, &directions, false)...)
+		for _, direction := range directions {
+			if direction.IsUnknown() || direction.IsNull() {
+				continue
+			}
+			if !integrationType.SupportsDirection(direction.ValueString()) {
+				resp.Diagnostics.AddAttributeError(path.Root("directions"), "Invalid Attribute",
+					fmt.Sprintf("%s integrations do not support the '%s' direction. Supported directions are: %s", integrationType.Name, direction.ValueString(), strings.Join(integrationType.Directions, ", ")))
+			}
+		}
+	}
+
+	if data.TypeSpecificProperties.IsUnknown() {
+		return
+	}
+
+	typeSpecificProperties := make(map[string]interface{})
+	if !data.TypeSpecificProperties.IsNull() {
+		resp.Diagnostics.Append(data.TypeSpecificProperties.Unmarshal(&typeSpecificProperties)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	errs, warnings := integrationType.ValidateProperties(typeSpecificProperties)
+	for _, err := range errs {
+		resp.Diagnostics.AddAttributeError(path.Root("type_specific_properties"), "Invalid Attribute", err)
+	}
+	for _, warning := range warnings {
+		resp.Diagnostics.AddAttributeWarning(path.Root("type_specific_properties"), "Unknown Type Specific Property", warning)
+	}
+}
+
+func (r *IntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring IntegrationResource")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Unexpected Resource Configure Type")
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+
+	tflog.Trace(ctx, "Configured IntegrationResource")
+}
+
+func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating the IntegrationResource")
+
+	var data dataModels.ApiIntegrationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	dtoObj := ApiIntegrationModelToDto(ctx, data)
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl("v1/integrations").
+		Method(httpClient.POST).
+		SetBody(dtoObj).
+		SetBodyParseObject(&dtoObj).
+		Send()
+
+	if httpResp == nil {
+		tflog.Error(ctx, "Client Error. Unable to create integration, got nil response")
+		resp.Diagnostics.AddError("Client Error", "Unable to create integration, got nil response")
+	} else if httpResp.IsError() {
+		statusCode := httpResp.GetStatusCode()
+		errorResponse := httpResp.GetErrorBody()
+		if errorResponse != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to create integration, status code: %d. Got response: %s", statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration, status code: %d. Got response: %s", statusCode, *errorResponse))
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to create integration, got http response: %d", statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration, got http response: %d", statusCode))
+		}
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to create integration, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration, got error: %s", err))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = IntegrationDtoToModel(dtoObj, data)
+
+	tflog.Trace(ctx, "Created the IntegrationResource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+	tflog.Trace(ctx, "Saved the IntegrationResource into Terraform state")
+}
+
+func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dataModels.ApiIntegrationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	tflog.Trace(ctx, "Reading the IntegrationResource")
+
+	ApiIntegration := dto.ApiIntegration{}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&ApiIntegration).
+		Send()
+
+	if httpResp == nil {
+		tflog.Error(ctx, "Client Error. Unable to read integration, got nil response")
+		resp.Diagnostics.AddError("Client Error", "Unable to read integration, got nil response")
+	} else if httpResp.IsError() {
+		statusCode := httpResp.GetStatusCode()
+		errorResponse := httpResp.GetErrorBody()
+		if errorResponse != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read integration, status code: %d. Got response: %s", statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration, status code: %d. Got response: %s", statusCode, *errorResponse))
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read integration, got http response: %d", statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration, got http response: %d", statusCode))
+		}
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read integration, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration or to parse received data, got error: %s", err))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = IntegrationDtoToModel(ApiIntegration, data)
+
+	tflog.Trace(ctx, "Read the IntegrationResource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+	tflog.Trace(ctx, "Saved the IntegrationResource into Terraform state")
+}
+
+func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data dataModels.ApiIntegrationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updating the IntegrationResource")
+
+	dtoObj := ApiIntegrationModelToDto(ctx, data)
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
+		Method(httpClient.PATCH).
+		SetBody(dtoObj).
+		SetBodyParseObject(&dtoObj).
+		Send()
+
+	if httpResp == nil {
+		tflog.Error(ctx, "Client Error. Unable to update integration, got nil response")
+		resp.Diagnostics.AddError("Client Error", "Unable to update integration, got nil response")
+	} else if httpResp.IsError() {
+		statusCode := httpResp.GetStatusCode()
+		errorResponse := httpResp.GetErrorBody()
+		if errorResponse != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to update integration, status code: %d. Got response: %s", statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update integration, status code: %d. Got response: %s", statusCode, *errorResponse))
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to update integration, got http response: %d", statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update integration, got http response: %d", statusCode))
+		}
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to update integration, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update integration, got error: %s", err))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = IntegrationDtoToModel(dtoObj, data)
+
+	tflog.Trace(ctx, "Updated the IntegrationResource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+	tflog.Trace(ctx, "Saved the IntegrationResource into Terraform state")
+}
+
+func (r *IntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data dataModels.ApiIntegrationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	tflog.Trace(ctx, "Deleting the IntegrationResource")
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
+		Method(httpClient.DELETE).
+		Send()
+
+	if httpResp == nil {
+		tflog.Error(ctx, "Client Error. Unable to delete integration, got nil response")
+		resp.Diagnostics.AddError("Client Error", "Unable to delete integration, got nil response")
+	} else if httpResp.IsError() {
+		statusCode := httpResp.GetStatusCode()
+		errorResponse := httpResp.GetErrorBody()
+		if errorResponse != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to delete integration, status code: %d. Got response: %s", statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete integration, status code: %d. Got response: %s", statusCode, *errorResponse))
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to delete integration, got http response: %d", statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete integration, got http response: %d", statusCode))
+		}
+	} else if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to delete integration, got http response: %d", httpResp.GetStatusCode()))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete integration, got error: %s", err))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted the IntegrationResource")
+}
+
+func (r *IntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
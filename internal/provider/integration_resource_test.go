@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIntegrationResource(t *testing.T) {
+	integrationName := uuid.NewString()
+	integrationUpdateName := uuid.NewString()
+
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	teamConfig := `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Plan-time validation testing
+			{
+				PlanOnly: true,
+				Config: providerConfig + teamConfig + `
+resource "atlassian-operations_integration" "example" {
+  name    = "` + integrationName + `"
+  team_id = atlassian-operations_team.example.id
+  type    = "Promethues"
+}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				PlanOnly: true,
+				Config: providerConfig + teamConfig + `
+resource "atlassian-operations_integration" "example" {
+  name       = "` + integrationName + `"
+  team_id    = atlassian-operations_team.example.id
+  type       = "Prometheus"
+  directions = ["outgoing"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Prometheus integrations do not support the 'outgoing' direction`),
+			},
+			{
+				PlanOnly: true,
+				Config: providerConfig + teamConfig + `
+resource "atlassian-operations_integration" "example" {
+  name    = "` + integrationName + `"
+  team_id = atlassian-operations_team.example.id
+  type    = "Prometheus"
+  type_specific_properties = jsonencode({
+    suppressNotifications = "no"
+  })
+}
+`,
+				ExpectError: regexp.MustCompile(`property 'suppressNotifications' of Prometheus integrations must be of type bool`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + teamConfig + `
+resource "atlassian-operations_integration" "example" {
+  name    = "` + integrationName + `"
+  team_id = atlassian-operations_team.example.id
+  type    = "Prometheus"
+  enabled = true
+  type_specific_properties = jsonencode({
+    suppressNotifications = false
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_integration.example", "name", integrationName),
+					resource.TestCheckResourceAttr("atlassian-operations_integration.example", "type", "Prometheus"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_integration.example", "team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_integration.example", "enabled", "true"),
+					resource.TestCheckResourceAttr("atlassian-operations_integration.example", "type_specific_properties", `{"suppressNotifications":false}`),
+					resource.TestCheckResourceAttrSet("atlassian-operations_integration.example", "api_key"),
+					resource.TestCheckResourceAttr("atlassian-operations_integration.example", "directions.#", "1"),
+					resource.TestCheckResourceAttr("atlassian-operations_integration.example", "directions.0", "incoming"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "atlassian-operations_integration.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"type_specific_properties", "api_key"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + teamConfig + `
+resource "atlassian-operations_integration" "example" {
+  name    = "` + integrationUpdateName + `"
+  team_id = atlassian-operations_team.example.id
+  type    = "Prometheus"
+  enabled = false
+  type_specific_properties = jsonencode({
+    suppressNotifications = true
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_integration.example", "name", integrationUpdateName),
+					resource.TestCheckResourceAttr("atlassian-operations_integration.example", "enabled", "false"),
+					resource.TestCheckResourceAttr("atlassian-operations_integration.example", "type_specific_properties", `{"suppressNotifications":true}`),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
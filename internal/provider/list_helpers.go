@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// fetchAllPages reads every page of a JSM Ops list endpoint, following the links.next URL returned by the API.
+func fetchAllPages[T any](clientConfiguration dto.AtlassianOpsProviderModel, url string, queryParams map[string]string) ([]T, error) {
+	values := make([]T, 0)
+	next := ""
+
+	for {
+		var page dto.ListResponse[T]
+
+		req := httpClientHelpers.GenerateJsmOpsClientRequest(clientConfiguration)
+		if next == "" {
+			req.JoinBaseUrl(url).SetQueryParams(queryParams)
+		} else {
+			req.SetUrl(next)
+		}
+
+		httpResp, err := req.
+			Method(httpClient.GET).
+			SetBodyParseObject(&page).
+			Send()
+
+		if err != nil {
+			return nil, err
+		} else if httpResp == nil {
+			return nil, fmt.Errorf("got nil response while listing %s", url)
+		} else if httpResp.IsError() {
+			statusCode := httpResp.GetStatusCode()
+			errorResponse := httpResp.GetErrorBody()
+			if errorResponse != nil {
+				return nil, fmt.Errorf("error while listing %s. Status Code: %d. Got response: %s", url, statusCode, *errorResponse)
+			}
+			return nil, fmt.Errorf("error while listing %s. Status Code: %d", url, statusCode)
+		}
+
+		values = append(values, page.Values...)
+		if page.Links.Next == "" || page.Links.Next == next {
+			break
+		}
+		next = page.Links.Next
+	}
+
+	return values, nil
+}
+
+// listedResource describes a remote object found by a list resource.
+type listedResource struct {
+	displayName string
+	// identity holds the identity attributes of the object, which share the names of the resource attributes.
+	identity map[string]types.String
+}
+
+// listResults streams a result for each of the listed objects, up to the requested limit. When the full resource is
+// requested, its identity is copied into an empty state which is read with the Read method of the resource, in the
+// same way as after an import.
+func listResults(ctx context.Context, req list.ListRequest, r resource.Resource, listed []listedResource) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range listed {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.displayName
+			for name, value := range item.identity {
+				result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(name), value)...)
+			}
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				state := tfsdk.State{Schema: result.Resource.Schema, Raw: result.Resource.Raw}
+				result.Diagnostics.Append(setStateFromIdentity(ctx, result.Identity, &state)...)
+
+				readResp := resource.ReadResponse{State: state, Identity: result.Identity}
+				r.Read(ctx, resource.ReadRequest{State: state, Identity: result.Identity}, &readResp)
+				result.Diagnostics.Append(readResp.Diagnostics...)
+				result.Resource.Raw = readResp.State.Raw
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// listError streams a single result holding the error raised while listing the remote objects.
+func listError(ctx context.Context, summary string, err error) iter.Seq[list.ListResult] {
+	tflog.Error(ctx, fmt.Sprintf("%s, got error: %s", summary, err))
+
+	var diags diag.Diagnostics
+	diags.AddError("Client Error", fmt.Sprintf("%s, got error: %s", summary, err))
+	return list.ListResultsStreamDiagnostics(diags)
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.Action = &MaintenanceCancelAction{}
+var _ action.ActionWithConfigure = &MaintenanceCancelAction{}
+
+func NewMaintenanceCancelAction() action.Action {
+	return &MaintenanceCancelAction{}
+}
+
+// MaintenanceCancelAction cancels an active or scheduled maintenance window before it ends.
+type MaintenanceCancelAction struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (a *MaintenanceCancelAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_cancel"
+}
+
+func (a *MaintenanceCancelAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Cancels an active or scheduled maintenance window.",
+		Attributes:  schemaAttributes.MaintenanceCancelActionAttributes,
+	}
+}
+
+func (a *MaintenanceCancelAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	a.clientConfiguration = client
+}
+
+func (a *MaintenanceCancelAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Trace(ctx, "Invoking the maintenance_cancel action")
+
+	var data dataModels.MaintenanceCancelActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var endpoint string
+	if !data.TeamId.IsNull() && data.TeamId.ValueString() != "" {
+		endpoint = fmt.Sprintf("/v1/teams/%s/maintenances/%s/cancel", data.TeamId.ValueString(), data.Id.ValueString())
+	} else {
+		endpoint = fmt.Sprintf("/v1/maintenances/%s/cancel", data.Id.ValueString())
+	}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(a.clientConfiguration).
+		JoinBaseUrl(endpoint).
+		Method(httpClient.POST).
+		Send()
+
+	handleHttpResponse(httpResp, err, "cancel maintenance", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Cancelled maintenance %s", data.Id.ValueString()),
+	})
+
+	tflog.Trace(ctx, "Invoked the maintenance_cancel action")
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccMaintenanceCancelAction(t *testing.T) {
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		// Actions are only supported from Terraform 1.14 onwards
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invoke the action after the trigger is created
+			{
+				Config: providerConfig + testAccMaintenanceCancelActionConfig(teamName, emailPrimary, organizationId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("atlassian-operations_maintenance.test", "id"),
+					resource.TestCheckResourceAttrPair("terraform_data.trigger", "output", "atlassian-operations_maintenance.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMaintenanceCancelActionConfig(teamName string, emailPrimary string, organizationId string) string {
+	return `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+  	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_api_integration" "example" {
+  name    = "` + teamName + `-api"
+  team_id = atlassian-operations_team.example.id
+  type    = "API"
+  enabled = true
+  type_specific_properties = jsonencode({
+    suppressNotifications: false
+  })
+}
+
+resource "atlassian-operations_maintenance" "test" {
+  description = "Test Maintenance Window"
+  start_date  = "2029-06-15T10:00:00Z"
+  end_date    = "2029-06-15T14:00:00Z"
+  team_id     = atlassian-operations_team.example.id
+
+  rules = [
+    {
+      state = "disabled"
+      entity = {
+        id   = atlassian-operations_api_integration.example.id
+        type = "integration"
+      }
+    }
+  ]
+}
+
+action "atlassian-operations_maintenance_cancel" "test" {
+  config {
+    id      = atlassian-operations_maintenance.test.id
+    team_id = atlassian-operations_maintenance.test.team_id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = atlassian-operations_maintenance.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.atlassian-operations_maintenance_cancel.test]
+    }
+  }
+}
+`
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &MaintenanceListResource{}
+var _ list.ListResourceWithConfigure = &MaintenanceListResource{}
+
+func NewMaintenanceListResource() list.ListResource {
+	return &MaintenanceListResource{}
+}
+
+// MaintenanceListResource lists the global maintenance windows, or the maintenance windows of a team.
+// The metadata, configuration and read logic are shared with MaintenanceResource.
+type MaintenanceListResource struct {
+	MaintenanceResource
+}
+
+func (r *MaintenanceListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.OptionallyTeamScopedListResourceAttributes,
+	}
+}
+
+func (r *MaintenanceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data dataModels.TeamScopedListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var url string
+	if data.TeamId.IsNull() {
+		url = "/v1/maintenances"
+	} else {
+		url = fmt.Sprintf("/v1/teams/%s/maintenances", data.TeamId.ValueString())
+	}
+
+	tflog.Trace(ctx, "Listing maintenances")
+
+	maintenances, err := fetchAllPages[dto.MaintenanceDto](r.clientConfiguration, url, nil)
+	if err != nil {
+		stream.Results = listError(ctx, "Unable to list maintenances", err)
+		return
+	}
+
+	listed := make([]listedResource, 0, len(maintenances))
+	for _, item := range maintenances {
+		listed = append(listed, listedResource{
+			displayName: fmt.Sprintf("%s (%s - %s)", item.Description, item.StartDate, item.EndDate),
+			identity: map[string]types.String{
+				"id":      types.StringValue(item.ID),
+				"team_id": data.TeamId,
+			},
+		})
+	}
+
+	stream.Results = listResults(ctx, req, &r.MaintenanceResource, listed)
+}
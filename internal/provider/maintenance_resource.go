@@ -4,33 +4,53 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &MaintenanceResource{}
-	_ resource.ResourceWithConfigure   = &MaintenanceResource{}
-	_ resource.ResourceWithImportState = &MaintenanceResource{}
+	_ resource.Resource                   = &MaintenanceResource{}
+	_ resource.ResourceWithConfigure      = &MaintenanceResource{}
+	_ resource.ResourceWithImportState    = &MaintenanceResource{}
+	_ resource.ResourceWithIdentity       = &MaintenanceResource{}
+	_ resource.ResourceWithValidateConfig = &MaintenanceResource{}
+	_ resource.ResourceWithModifyPlan     = &MaintenanceResource{}
 )
 
 // MaintenanceResource defines the resource implementation for maintenances
 type MaintenanceResource struct {
 	clientConfiguration dto.AtlassianOpsProviderModel
+	// now returns the current time, time.Now when not set
+	now func() time.Time
 }
 
 func NewMaintenanceResource() resource.Resource {
 	return &MaintenanceResource{}
 }
 
+// currentTime returns the current time, from which the windows of recurring maintenances are computed.
+func (r *MaintenanceResource) currentTime() time.Time {
+	if r.now == nil {
+		return time.Now()
+	}
+	return r.now()
+}
+
 // Metadata returns metadata for the resource
 func (r *MaintenanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_maintenance"
@@ -44,6 +64,201 @@ func (r *MaintenanceResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *MaintenanceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.OptionallyTeamScopedIdentityAttributes,
+	}
+}
+
+// ValidateConfig checks the dates and the schedule of the maintenance window
+func (r *MaintenanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var startDate, endDate timetypes.RFC3339
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("start_date"), &startDate)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("end_date"), &endDate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !startDate.IsNull() && !startDate.IsUnknown() && !endDate.IsNull() && !endDate.IsUnknown() {
+		start, startDiags := startDate.ValueRFC3339Time()
+		end, endDiags := endDate.ValueRFC3339Time()
+		// Invalid dates are reported by the type of the attributes
+		if !startDiags.HasError() && !endDiags.HasError() && !end.After(start) {
+			resp.Diagnostics.AddAttributeError(path.Root("end_date"), "Invalid Attribute",
+				fmt.Sprintf("The end date of the maintenance window must be after its start date, got: %s - %s", startDate.ValueString(), endDate.ValueString()))
+		}
+	}
+
+	var schedule dataModels.MaintenanceScheduleModel
+	diags := req.Config.GetAttribute(ctx, path.Root("schedule"), &schedule)
+	if diags.HasError() {
+		// The schedule is either not set or not fully known yet
+		return
+	}
+
+	if schedule.Days.IsUnknown() || schedule.StartTime.IsUnknown() || schedule.Timezone.IsUnknown() || schedule.Duration.IsUnknown() {
+		return
+	}
+	if schedule.Timezone.IsNull() {
+		schedule.Timezone = types.StringValue("UTC")
+	}
+
+	_, diags = parseMaintenanceSchedule(ctx, schedule)
+	for _, d := range diags {
+		resp.Diagnostics.AddAttributeError(path.Root("schedule"), d.Summary(), d.Detail())
+	}
+}
+
+// ModifyPlan expands the typed attributes into rules, computes the window of a recurring maintenance, and checks the
+// lifecycle of the maintenance window.
+// Completed maintenance windows can't be updated: a recurring maintenance is replaced with its next upcoming window
+// once the current one completes, while the changes to other completed windows are planned as a replacement.
+func (r *MaintenanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan dataModels.MaintenanceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := r.currentTime()
+	var state *dataModels.MaintenanceModel
+	if !req.State.Raw.IsNull() {
+		state = &dataModels.MaintenanceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	completed := state != nil && maintenanceCompleted(*state, now)
+
+	if hasMaintenanceRuleEntityIds(plan) {
+		rules, diags := expandMaintenanceRules(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rules"), rules)...)
+		// The referenced integrations and policies are checked so that the window doesn't silently apply to nothing
+		resp.Diagnostics.Append(r.checkRuleEntities(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.Schedule.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_start"), timetypes.NewRFC3339Null())...)
+
+		if state == nil && !plan.EndDate.IsNull() && !plan.EndDate.IsUnknown() {
+			if end, diags := plan.EndDate.ValueRFC3339Time(); !diags.HasError() && !end.After(now) {
+				resp.Diagnostics.AddAttributeError(path.Root("end_date"), "Invalid Attribute",
+					fmt.Sprintf("The end date of a new maintenance window must be in the future, got: %s", plan.EndDate.ValueString()))
+			}
+		} else if completed {
+			r.replaceCompleted(ctx, req, resp, *state)
+		}
+		return
+	}
+	if plan.Schedule.IsUnknown() {
+		return
+	}
+
+	var scheduleModel dataModels.MaintenanceScheduleModel
+	resp.Diagnostics.Append(plan.Schedule.As(ctx, &scheduleModel, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || scheduleModel.Days.IsUnknown() || scheduleModel.StartTime.IsUnknown() || scheduleModel.Timezone.IsUnknown() || scheduleModel.Duration.IsUnknown() {
+		return
+	}
+
+	schedule, diags := parseMaintenanceSchedule(ctx, scheduleModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state != nil && !completed && state.Schedule.Equal(plan.Schedule) {
+		// The current window is kept until it completes
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("start_date"), state.StartDate)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("end_date"), state.EndDate)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_start"), state.NextStart)...)
+		return
+	}
+
+	var current time.Time
+	if state != nil && !completed && !state.StartDate.IsNull() && !state.StartDate.IsUnknown() {
+		current, _ = state.StartDate.ValueRFC3339Time()
+	}
+	if !current.IsZero() && !current.After(now) {
+		// The window in progress keeps its start date when the schedule changes, only its end and the next window
+		// follow the new schedule
+		start, end, nextStart := schedule.windowFrom(current)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("start_date"), timetypes.NewRFC3339TimeValue(start.UTC()))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("end_date"), timetypes.NewRFC3339TimeValue(end.UTC()))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_start"), timetypes.NewRFC3339TimeValue(nextStart.UTC()))...)
+		return
+	}
+
+	// The next window is computed from the time of the apply. Computing it from the time of the plan would make the
+	// plan recomputed at apply time inconsistent, when a window of the schedule starts in between
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("start_date"), timetypes.NewRFC3339Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("end_date"), timetypes.NewRFC3339Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_start"), timetypes.NewRFC3339Unknown())...)
+
+	if completed {
+		// Completed maintenance windows can't be updated, the next window is created instead
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("start_date"))
+	}
+}
+
+// setScheduledWindow sets the dates of a recurring maintenance left unknown by ModifyPlan to its next window.
+func (r *MaintenanceResource) setScheduledWindow(ctx context.Context, plan *dataModels.MaintenanceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.Schedule.IsNull() || plan.Schedule.IsUnknown() || !plan.StartDate.IsUnknown() {
+		return diags
+	}
+
+	var scheduleModel dataModels.MaintenanceScheduleModel
+	diags.Append(plan.Schedule.As(ctx, &scheduleModel, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+	schedule, scheduleDiags := parseMaintenanceSchedule(ctx, scheduleModel)
+	diags.Append(scheduleDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	start, end, nextStart := schedule.window(r.currentTime())
+	plan.StartDate = timetypes.NewRFC3339TimeValue(start.UTC())
+	plan.EndDate = timetypes.NewRFC3339TimeValue(end.UTC())
+	plan.NextStart = timetypes.NewRFC3339TimeValue(nextStart.UTC())
+	return diags
+}
+
+// replaceCompleted plans the replacement of a completed maintenance window whose configuration changed.
+func (r *MaintenanceResource) replaceCompleted(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, state dataModels.MaintenanceModel) {
+	var changed path.Paths
+	for _, attributeName := range []string{"description", "start_date", "end_date", "team_id", "rules"} {
+		var planValue, stateValue attr.Value
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root(attributeName), &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(attributeName), &stateValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !planValue.Equal(stateValue) {
+			changed = append(changed, path.Root(attributeName))
+		}
+	}
+
+	if len(changed) == 0 {
+		return
+	}
+
+	resp.RequiresReplace = append(resp.RequiresReplace, changed...)
+	resp.Diagnostics.AddWarning("Completed Maintenance Window",
+		fmt.Sprintf("The maintenance window %s has completed (status: %s) and can't be updated anymore, it will be replaced with a new maintenance window.", state.ID.ValueString(), state.Status.ValueString()))
+}
+
 // Configure sets up the resource with provider configuration
 func (r *MaintenanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring MaintenanceResource")
@@ -75,6 +290,11 @@ func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	resp.Diagnostics.Append(r.setScheduledWindow(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert to DTO
 	maintenanceDto, diags := MaintenanceModelToDto(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -124,9 +344,24 @@ func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Update state with response
+	if hasMaintenanceRuleEntityIds(plan) {
+		sortMaintenanceRules(maintenanceDto.Rules)
+	}
 	result, diags := MaintenanceDtoToModel(ctx, maintenanceDto)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The schedule is not stored by the API, it is kept as planned
+	result.Schedule = plan.Schedule
+	result.NextStart = plan.NextStart
+	result.CancelOnDestroy = plan.CancelOnDestroy
+	result.IntegrationIds = plan.IntegrationIds
+	result.PolicyIds = plan.PolicyIds
+	result.SyncIds = plan.SyncIds
+	result.State = plan.State
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 // Read handles the read operation for the resource
@@ -185,15 +420,52 @@ func (r *MaintenanceResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	if hasMaintenanceRuleEntityIds(state) {
+		sortMaintenanceRules(maintenanceDto.Rules)
+	}
 	result, diags := MaintenanceDtoToModel(ctx, &maintenanceDto)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The schedule is not stored by the API, it is kept from the state
+	result.Schedule = state.Schedule
+	result.NextStart = state.NextStart
+	if !state.CancelOnDestroy.IsNull() {
+		result.CancelOnDestroy = state.CancelOnDestroy
+	}
+	// The typed attributes are kept from the state, changes to the rules outside of Terraform show as a difference
+	result.IntegrationIds = state.IntegrationIds
+	result.PolicyIds = state.PolicyIds
+	result.SyncIds = state.SyncIds
+	result.State = state.State
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 // Update handles the update operation for the resource
 func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan dataModels.MaintenanceModel
+	var plan, state dataModels.MaintenanceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if maintenanceCompleted(state, r.currentTime()) {
+		// The other changes of completed windows are planned as a replacement, only the attributes which are not sent to
+		// the API are left to update
+		state.CancelOnDestroy = plan.CancelOnDestroy
+		state.IntegrationIds = plan.IntegrationIds
+		state.PolicyIds = plan.PolicyIds
+		state.SyncIds = plan.SyncIds
+		state.State = plan.State
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+		return
+	}
+
+	resp.Diagnostics.Append(r.setScheduledWindow(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -247,9 +519,24 @@ func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	if hasMaintenanceRuleEntityIds(plan) {
+		sortMaintenanceRules(maintenanceDto.Rules)
+	}
 	result, diags := MaintenanceDtoToModel(ctx, maintenanceDto)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The schedule is not stored by the API, it is kept as planned
+	result.Schedule = plan.Schedule
+	result.NextStart = plan.NextStart
+	result.CancelOnDestroy = plan.CancelOnDestroy
+	result.IntegrationIds = plan.IntegrationIds
+	result.PolicyIds = plan.PolicyIds
+	result.SyncIds = plan.SyncIds
+	result.State = plan.State
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 // Delete handles the delete operation for the resource
@@ -268,6 +555,20 @@ func (r *MaintenanceResource) Delete(ctx context.Context, req resource.DeleteReq
 		endpoint = fmt.Sprintf("/v1/maintenances/%s", state.ID.ValueString())
 	}
 
+	if state.CancelOnDestroy.ValueBool() {
+		now := r.currentTime()
+		if maintenanceCompleted(state, now) {
+			tflog.Info(ctx, fmt.Sprintf("Maintenance window %s has completed, it is kept in the history", state.ID.ValueString()))
+			return
+		}
+
+		start, diags := state.StartDate.ValueRFC3339Time()
+		if !diags.HasError() && !start.After(now) {
+			r.cancel(ctx, endpoint, resp)
+			return
+		}
+	}
+
 	httpResp, err := httpClientHelpers.
 		GenerateJsmOpsClientRequest(r.clientConfiguration).
 		JoinBaseUrl(endpoint).
@@ -300,8 +601,25 @@ func (r *MaintenanceResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+// cancel ends an active maintenance window, keeping it in the history
+func (r *MaintenanceResource) cancel(ctx context.Context, endpoint string, resp *resource.DeleteResponse) {
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(endpoint + "/cancel").
+		Method(httpClient.POST).
+		Send()
+
+	handleHttpResponse(httpResp, err, "cancel maintenance window", &resp.Diagnostics, ctx)
+}
+
 // ImportState handles importing the state of an existing resource
 func (r *MaintenanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) != 1 && len(idParts) != 2 {
 		resp.Diagnostics.AddError(
@@ -317,3 +635,17 @@ func (r *MaintenanceResource) ImportState(ctx context.Context, req resource.Impo
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), idParts[1])...)
 	}
 }
+
+// maintenanceCompleted tells whether a maintenance window is over, either because it was cancelled or because its end
+// date has passed.
+func maintenanceCompleted(model dataModels.MaintenanceModel, now time.Time) bool {
+	if model.Status.ValueString() == "past" || model.Status.ValueString() == "cancelled" {
+		return true
+	}
+
+	if model.EndDate.IsNull() || model.EndDate.IsUnknown() {
+		return false
+	}
+	end, diags := model.EndDate.ValueRFC3339Time()
+	return !diags.HasError() && !end.After(now)
+}
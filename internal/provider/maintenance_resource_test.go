@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -59,6 +60,51 @@ func TestAccMaintenanceResource(t *testing.T) {
 	})
 }
 
+func TestAccMaintenanceResourceWithSchedule(t *testing.T) {
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	teamName := uuid.NewString()
+	apiIntegrationName := uuid.NewString()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the next upcoming window
+			{
+				Config: providerConfig + testAccMaintenanceResourceWithScheduleConfig(emailPrimary, teamName, organizationId, apiIntegrationName, "4h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.scheduled", "schedule.start_time", "02:00"),
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.scheduled", "schedule.timezone", "UTC"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_maintenance.scheduled", "start_date"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_maintenance.scheduled", "end_date"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_maintenance.scheduled", "next_start"),
+				),
+			},
+			// The window is kept while it has not completed
+			{
+				Config:   providerConfig + testAccMaintenanceResourceWithScheduleConfig(emailPrimary, teamName, organizationId, apiIntegrationName, "4h"),
+				PlanOnly: true,
+			},
+			// Update the duration of the window
+			{
+				Config: providerConfig + testAccMaintenanceResourceWithScheduleConfig(emailPrimary, teamName, organizationId, apiIntegrationName, "6h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.scheduled", "schedule.duration", "6h"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func TestAccMaintenanceResourceWithTeam(t *testing.T) {
 	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
 	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
@@ -114,6 +160,83 @@ func TestAccMaintenanceResourceWithTeam(t *testing.T) {
 	})
 }
 
+func TestAccMaintenanceResourceWithCancelOnDestroy(t *testing.T) {
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	teamName := uuid.NewString()
+	apiIntegrationName := uuid.NewString()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid dates are rejected at plan time
+			{
+				Config:      providerConfig + testAccMaintenanceResourceWithCancelOnDestroyConfig(emailPrimary, teamName, organizationId, apiIntegrationName, "2029-06-15T14:00:00Z", "2029-06-15T10:00:00Z"),
+				ExpectError: regexp.MustCompile(`must\s+be\s+after\s+its\s+start\s+date`),
+			},
+			{
+				Config:      providerConfig + testAccMaintenanceResourceWithCancelOnDestroyConfig(emailPrimary, teamName, organizationId, apiIntegrationName, "2020-06-15T10:00:00Z", "2020-06-15T14:00:00Z"),
+				ExpectError: regexp.MustCompile(`must\s+be\s+in\s+the\s+future`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccMaintenanceResourceWithCancelOnDestroyConfig(emailPrimary, teamName, organizationId, apiIntegrationName, "2029-06-15T10:00:00Z", "2029-06-15T14:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.cancelled", "cancel_on_destroy", "true"),
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.cancelled", "status", "planned"),
+				),
+			},
+			// Planned windows are deleted, delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMaintenanceResourceWithEntityIds(t *testing.T) {
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	teamName := uuid.NewString()
+	apiIntegrationName := uuid.NewString()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccMaintenanceResourceWithEntityIdsConfig(emailPrimary, teamName, organizationId, apiIntegrationName, `[atlassian-operations_api_integration.example.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.typed", "integration_ids.#", "1"),
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.typed", "rules.#", "1"),
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.typed", "rules.0.state", "noMaintenance"),
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.typed", "rules.0.entity.type", "integration"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_maintenance.typed", "rules.0.entity.id", "atlassian-operations_api_integration.example", "id"),
+				),
+			},
+			// Unknown integrations are rejected at plan time
+			{
+				Config:      providerConfig + testAccMaintenanceResourceWithEntityIdsConfig(emailPrimary, teamName, organizationId, apiIntegrationName, `["`+uuid.NewString()+`"]`),
+				ExpectError: regexp.MustCompile(`does not exist`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccMaintenanceResourceConfig(apiPrimary string, teamName string, organizationId string, apiIntegrationName string) string {
 	return `
 data "atlassian-operations_user" "test1" {
@@ -288,3 +411,132 @@ resource "atlassian-operations_maintenance" "team_test" {
 }
 `
 }
+
+func testAccMaintenanceResourceWithScheduleConfig(apiPrimary string, teamName string, organizationId string, apiIntegrationName string, duration string) string {
+	return `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + apiPrimary + `"
+  	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_api_integration" "example" {
+  name    = "` + apiIntegrationName + `"
+  team_id = atlassian-operations_team.example.id
+  type = "API"
+  enabled = true
+}
+
+resource "atlassian-operations_maintenance" "scheduled" {
+  description = "Weekly Maintenance Window"
+  schedule = {
+    days       = ["sunday", "wednesday"]
+    start_time = "02:00"
+    duration   = "` + duration + `"
+  }
+
+  rules = [
+	{
+    	state = "disabled"
+    	entity = {
+      		id   = atlassian-operations_api_integration.example.id
+      		type = "integration"
+    	}
+  	}
+	]
+}
+`
+}
+
+func testAccMaintenanceResourceWithCancelOnDestroyConfig(apiPrimary string, teamName string, organizationId string, apiIntegrationName string, startDate string, endDate string) string {
+	return `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + apiPrimary + `"
+  	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_api_integration" "example" {
+  name    = "` + apiIntegrationName + `"
+  team_id = atlassian-operations_team.example.id
+  type = "API"
+  enabled = true
+}
+
+resource "atlassian-operations_maintenance" "cancelled" {
+  description       = "Cancelled Maintenance Window"
+  start_date        = "` + startDate + `"
+  end_date          = "` + endDate + `"
+  cancel_on_destroy = true
+
+  rules = [ {
+    state = "disabled"
+    entity = {
+      id   = atlassian-operations_api_integration.example.id
+      type = "integration"
+    }
+  }
+  ]
+}
+`
+}
+
+func testAccMaintenanceResourceWithEntityIdsConfig(apiPrimary string, teamName string, organizationId string, apiIntegrationName string, integrationIds string) string {
+	return `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + apiPrimary + `"
+  	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_api_integration" "example" {
+  name    = "` + apiIntegrationName + `"
+  team_id = atlassian-operations_team.example.id
+  type = "API"
+  enabled = true
+}
+
+resource "atlassian-operations_maintenance" "typed" {
+  description = "Typed Maintenance Window"
+  start_date  = "2029-06-15T10:00:00Z"
+  end_date    = "2029-06-15T14:00:00Z"
+  team_id     = atlassian-operations_team.example.id
+
+  integration_ids = ` + integrationIds + `
+  state           = "noMaintenance"
+}
+`
+}
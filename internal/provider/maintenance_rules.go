@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maintenanceRuleEntityTypes are the entity types of the maintenance rules, in the order the rules expanded from the
+// typed attributes are listed.
+var maintenanceRuleEntityTypes = []string{"integration", "policy", "sync"}
+
+// maintenanceRuleDefaultState is the state applied to the entities of the typed attributes when state is not set.
+const maintenanceRuleDefaultState = "disabled"
+
+// maintenanceRuleEntityIds returns the typed attributes of a maintenance, by entity type.
+func maintenanceRuleEntityIds(model dataModels.MaintenanceModel) map[string]types.Set {
+	return map[string]types.Set{
+		"integration": model.IntegrationIds,
+		"policy":      model.PolicyIds,
+		"sync":        model.SyncIds,
+	}
+}
+
+// hasMaintenanceRuleEntityIds tells whether the rules of a maintenance are expanded from its typed attributes.
+func hasMaintenanceRuleEntityIds(model dataModels.MaintenanceModel) bool {
+	for _, ids := range maintenanceRuleEntityIds(model) {
+		if !ids.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// expandMaintenanceRules builds the rules of a maintenance from its typed attributes: one rule per entity, listed by
+// entity type and then by ID. The rules are unknown until all the IDs are known.
+func expandMaintenanceRules(ctx context.Context, model dataModels.MaintenanceModel) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	state := maintenanceRuleDefaultState
+	if model.State.IsUnknown() {
+		return types.ListUnknown(dataModels.MaintenanceRuleObjectType), diags
+	} else if !model.State.IsNull() {
+		state = model.State.ValueString()
+	}
+
+	entityIds := maintenanceRuleEntityIds(model)
+	var rules []attr.Value
+	for _, entityType := range maintenanceRuleEntityTypes {
+		if entityIds[entityType].IsUnknown() {
+			return types.ListUnknown(dataModels.MaintenanceRuleObjectType), diags
+		}
+
+		var ids []types.String
+		diags.Append(entityIds[entityType].ElementsAs(ctx, &ids, false)...)
+		if diags.HasError() {
+			return types.ListNull(dataModels.MaintenanceRuleObjectType), diags
+		}
+
+		values := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if id.IsUnknown() {
+				return types.ListUnknown(dataModels.MaintenanceRuleObjectType), diags
+			}
+			values = append(values, id.ValueString())
+		}
+		sort.Strings(values)
+
+		for _, id := range values {
+			entity, entityDiags := types.ObjectValue(dataModels.MaintenanceRuleEntityObjectType.AttrTypes, map[string]attr.Value{
+				"id":   types.StringValue(id),
+				"type": types.StringValue(entityType),
+			})
+			diags.Append(entityDiags...)
+
+			rule, ruleDiags := types.ObjectValue(dataModels.MaintenanceRuleObjectType.AttrTypes, map[string]attr.Value{
+				"state":  types.StringValue(state),
+				"entity": entity,
+			})
+			diags.Append(ruleDiags...)
+			rules = append(rules, rule)
+		}
+	}
+	if diags.HasError() {
+		return types.ListNull(dataModels.MaintenanceRuleObjectType), diags
+	}
+
+	rulesList, listDiags := types.ListValue(dataModels.MaintenanceRuleObjectType, rules)
+	diags.Append(listDiags...)
+	return rulesList, diags
+}
+
+// sortMaintenanceRules sorts the rules returned by the API in the order of expandMaintenanceRules, so that the rules
+// expanded from the typed attributes don't show a difference when the API lists them in another order.
+func sortMaintenanceRules(rules []dto.MaintenanceRuleDto) {
+	typeOrder := make(map[string]int, len(maintenanceRuleEntityTypes))
+	for i, entityType := range maintenanceRuleEntityTypes {
+		typeOrder[entityType] = i
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Entity.Type != rules[j].Entity.Type {
+			return typeOrder[rules[i].Entity.Type] < typeOrder[rules[j].Entity.Type]
+		}
+		return rules[i].Entity.ID < rules[j].Entity.ID
+	})
+}
+
+// checkRuleEntities checks that the integrations and policies of the typed attributes exist, and belong to the team of
+// the maintenance window. IDs which are not known yet, and the syncs, are not checked.
+func (r *MaintenanceResource) checkRuleEntities(ctx context.Context, plan dataModels.MaintenanceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.TeamID.IsUnknown() {
+		return diags
+	}
+	teamId := plan.TeamID.ValueString()
+
+	for _, id := range knownSetStrings(ctx, plan.IntegrationIds, &diags) {
+		var integration dto.ApiIntegration
+		found := r.fetchRuleEntity(ctx, fmt.Sprintf("v1/integrations/%s", id), &integration, "integration", &diags)
+		if diags.HasError() {
+			return diags
+		}
+
+		if !found {
+			diags.AddAttributeError(path.Root("integration_ids"), "Invalid Attribute",
+				fmt.Sprintf("The integration %s does not exist", id))
+		} else if teamId != "" && integration.TeamId != teamId {
+			diags.AddAttributeError(path.Root("integration_ids"), "Invalid Attribute",
+				fmt.Sprintf("The integration %s does not belong to the team %s of the maintenance window", id, teamId))
+		}
+	}
+
+	for _, id := range knownSetStrings(ctx, plan.PolicyIds, &diags) {
+		endpoint := fmt.Sprintf("/v1/alerts/policies/%s", id)
+		if teamId != "" {
+			endpoint = fmt.Sprintf("/v1/teams/%s/policies/%s", teamId, id)
+		}
+
+		var policy dto.AlertPolicyDto
+		found := r.fetchRuleEntity(ctx, endpoint, &policy, "policy", &diags)
+		if diags.HasError() {
+			return diags
+		}
+
+		if !found && teamId != "" {
+			diags.AddAttributeError(path.Root("policy_ids"), "Invalid Attribute",
+				fmt.Sprintf("The policy %s does not exist in the team %s of the maintenance window", id, teamId))
+		} else if !found {
+			diags.AddAttributeError(path.Root("policy_ids"), "Invalid Attribute",
+				fmt.Sprintf("The global alert policy %s does not exist", id))
+		}
+	}
+
+	return diags
+}
+
+// fetchRuleEntity reads an entity referenced by a maintenance rule, and tells whether it was found.
+func (r *MaintenanceResource) fetchRuleEntity(ctx context.Context, endpoint string, target interface{}, entityType string, diags *diag.Diagnostics) bool {
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(endpoint).
+		Method(httpClient.GET).
+		SetBodyParseObject(target).
+		Send()
+
+	if httpResp != nil && httpResp.IsError() && httpResp.GetStatusCode() == 404 {
+		return false
+	}
+
+	var readDiags diag.Diagnostics
+	handleHttpResponse(httpResp, err, fmt.Sprintf("read %s", entityType), &readDiags, ctx)
+	diags.Append(readDiags...)
+	return !readDiags.HasError()
+}
+
+// knownSetStrings returns the known elements of a set of strings.
+func knownSetStrings(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	var elements []types.String
+	diags.Append(set.ElementsAs(ctx, &elements, false)...)
+
+	values := make([]string, 0, len(elements))
+	for _, element := range elements {
+		if !element.IsUnknown() && !element.IsNull() {
+			values = append(values, element.ValueString())
+		}
+	}
+	sort.Strings(values)
+	return values
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	// The time zones of maintenance schedules are resolved without relying on the time zone database of the host
+	_ "time/tzdata"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var maintenanceScheduleDays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// maintenanceSchedule is the parsed weekly recurrence of a maintenance window.
+type maintenanceSchedule struct {
+	days     map[time.Weekday]bool
+	hour     int
+	minute   int
+	location *time.Location
+	duration time.Duration
+}
+
+// parseMaintenanceSchedule parses the schedule attribute of a maintenance. The format of the days and of the start time
+// is checked by the attribute validators.
+func parseMaintenanceSchedule(ctx context.Context, model dataModels.MaintenanceScheduleModel) (*maintenanceSchedule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var days []string
+	diags.Append(model.Days.ElementsAs(ctx, &days, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	schedule := &maintenanceSchedule{days: make(map[time.Weekday]bool)}
+	for _, day := range days {
+		weekday, ok := maintenanceScheduleDays[day]
+		if !ok {
+			diags.AddError("Invalid Attribute", fmt.Sprintf("The schedule day must be a day of the week, got: %s", day))
+			return nil, diags
+		}
+		schedule.days[weekday] = true
+	}
+	if len(schedule.days) == 0 {
+		diags.AddError("Invalid Attribute", "The schedule must start on at least one day of the week")
+		return nil, diags
+	}
+
+	hour, minute, found := strings.Cut(model.StartTime.ValueString(), ":")
+	if !found {
+		diags.AddError("Invalid Attribute", fmt.Sprintf("The schedule start time must be in HH:MM format, got: %s", model.StartTime.ValueString()))
+		return nil, diags
+	}
+	schedule.hour, _ = strconv.Atoi(hour)
+	schedule.minute, _ = strconv.Atoi(minute)
+
+	location, err := time.LoadLocation(model.Timezone.ValueString())
+	if err != nil {
+		diags.AddError("Invalid Attribute", fmt.Sprintf("The schedule timezone must be an IANA time zone, got: %s", model.Timezone.ValueString()))
+		return nil, diags
+	}
+	schedule.location = location
+
+	duration, err := time.ParseDuration(model.Duration.ValueString())
+	if err != nil || duration <= 0 {
+		diags.AddError("Invalid Attribute", fmt.Sprintf("The schedule duration must be a positive duration such as '4h' or '90m', got: %s", model.Duration.ValueString()))
+		return nil, diags
+	}
+	schedule.duration = duration
+
+	return schedule, diags
+}
+
+// next returns the start of the first occurrence of the schedule after the given time.
+func (s *maintenanceSchedule) next(after time.Time) time.Time {
+	local := after.In(s.location)
+	// Every day of the week is found within 8 days, including the day of the given time
+	for offset := 0; offset <= 7; offset++ {
+		start := time.Date(local.Year(), local.Month(), local.Day()+offset, s.hour, s.minute, 0, 0, s.location)
+		if start.After(after) && s.days[start.Weekday()] {
+			return start
+		}
+	}
+	return time.Time{}
+}
+
+// window returns the start and end of the first window of the schedule after the given time, and the start of the
+// window after it.
+func (s *maintenanceSchedule) window(after time.Time) (start time.Time, end time.Time, nextStart time.Time) {
+	start = s.next(after)
+	return start, start.Add(s.duration), s.next(start)
+}
+
+// windowFrom returns the end of the window which started at start with the duration of the schedule, and the start
+// of the window after it. It only depends on start, so that the window in progress is planned the same way at plan
+// and at apply time.
+func (s *maintenanceSchedule) windowFrom(start time.Time) (time.Time, time.Time, time.Time) {
+	end := start.Add(s.duration)
+	return start, end, s.next(end)
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testMaintenanceScheduleModel(days []string, startTime string, timezone string, duration string) dataModels.MaintenanceScheduleModel {
+	dayValues := make([]attr.Value, len(days))
+	for i, day := range days {
+		dayValues[i] = types.StringValue(day)
+	}
+	return dataModels.MaintenanceScheduleModel{
+		Days:      types.SetValueMust(types.StringType, dayValues),
+		StartTime: types.StringValue(startTime),
+		Timezone:  types.StringValue(timezone),
+		Duration:  types.StringValue(duration),
+	}
+}
+
+func TestParseMaintenanceSchedule(t *testing.T) {
+	testCases := []struct {
+		name          string
+		model         dataModels.MaintenanceScheduleModel
+		expectedError bool
+	}{
+		{
+			name:  "valid schedule",
+			model: testMaintenanceScheduleModel([]string{"monday", "wednesday"}, "22:00", "Europe/Istanbul", "4h"),
+		},
+		{
+			name:          "unknown day",
+			model:         testMaintenanceScheduleModel([]string{"someday"}, "22:00", "UTC", "4h"),
+			expectedError: true,
+		},
+		{
+			name:          "no day",
+			model:         testMaintenanceScheduleModel(nil, "22:00", "UTC", "4h"),
+			expectedError: true,
+		},
+		{
+			name:          "start time without minutes",
+			model:         testMaintenanceScheduleModel([]string{"monday"}, "22", "UTC", "4h"),
+			expectedError: true,
+		},
+		{
+			name:          "unknown time zone",
+			model:         testMaintenanceScheduleModel([]string{"monday"}, "22:00", "Mars/Olympus", "4h"),
+			expectedError: true,
+		},
+		{
+			name:          "negative duration",
+			model:         testMaintenanceScheduleModel([]string{"monday"}, "22:00", "UTC", "-4h"),
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			schedule, diags := parseMaintenanceSchedule(context.Background(), testCase.model)
+			if diags.HasError() != testCase.expectedError {
+				t.Fatalf("expected error %t, got diagnostics: %v", testCase.expectedError, diags)
+			}
+			if testCase.expectedError {
+				return
+			}
+
+			if !schedule.days[time.Monday] || !schedule.days[time.Wednesday] || len(schedule.days) != 2 {
+				t.Errorf("expected the days monday and wednesday, got %v", schedule.days)
+			}
+			if schedule.hour != 22 || schedule.minute != 0 {
+				t.Errorf("expected the start time 22:00, got %02d:%02d", schedule.hour, schedule.minute)
+			}
+			if schedule.location.String() != "Europe/Istanbul" {
+				t.Errorf("expected the time zone Europe/Istanbul, got %s", schedule.location)
+			}
+			if schedule.duration != 4*time.Hour {
+				t.Errorf("expected the duration 4h, got %s", schedule.duration)
+			}
+		})
+	}
+}
+
+func TestMaintenanceScheduleWindow(t *testing.T) {
+	// Every Monday and Wednesday at 22:00 UTC, for 4 hours
+	schedule, diags := parseMaintenanceSchedule(context.Background(), testMaintenanceScheduleModel([]string{"monday", "wednesday"}, "22:00", "UTC", "4h"))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	testCases := []struct {
+		name              string
+		window            func() (time.Time, time.Time, time.Time)
+		expectedStart     time.Time
+		expectedEnd       time.Time
+		expectedNextStart time.Time
+	}{
+		{
+			name: "next window, during a window",
+			window: func() (time.Time, time.Time, time.Time) {
+				return schedule.window(time.Date(2026, time.October, 19, 23, 0, 0, 0, time.UTC))
+			},
+			expectedStart:     time.Date(2026, time.October, 21, 22, 0, 0, 0, time.UTC),
+			expectedEnd:       time.Date(2026, time.October, 22, 2, 0, 0, 0, time.UTC),
+			expectedNextStart: time.Date(2026, time.October, 26, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "next window, on the same day",
+			window: func() (time.Time, time.Time, time.Time) {
+				return schedule.window(time.Date(2026, time.October, 19, 21, 59, 0, 0, time.UTC))
+			},
+			expectedStart:     time.Date(2026, time.October, 19, 22, 0, 0, 0, time.UTC),
+			expectedEnd:       time.Date(2026, time.October, 20, 2, 0, 0, 0, time.UTC),
+			expectedNextStart: time.Date(2026, time.October, 21, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "window in progress, started before the schedule changed",
+			window: func() (time.Time, time.Time, time.Time) {
+				return schedule.windowFrom(time.Date(2026, time.October, 19, 21, 30, 0, 0, time.UTC))
+			},
+			expectedStart:     time.Date(2026, time.October, 19, 21, 30, 0, 0, time.UTC),
+			expectedEnd:       time.Date(2026, time.October, 20, 1, 30, 0, 0, time.UTC),
+			expectedNextStart: time.Date(2026, time.October, 21, 22, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			start, end, nextStart := testCase.window()
+			if !start.Equal(testCase.expectedStart) {
+				t.Errorf("expected start %s, got %s", testCase.expectedStart, start)
+			}
+			if !end.Equal(testCase.expectedEnd) {
+				t.Errorf("expected end %s, got %s", testCase.expectedEnd, end)
+			}
+			if !nextStart.Equal(testCase.expectedNextStart) {
+				t.Errorf("expected next start %s, got %s", testCase.expectedNextStart, nextStart)
+			}
+		})
+	}
+}
+
+func TestMaintenanceResourceSetScheduledWindow(t *testing.T) {
+	ctx := context.Background()
+	// A Monday, during the window of the schedule in Istanbul
+	now := time.Date(2026, time.October, 19, 20, 0, 0, 0, time.UTC)
+	r := &MaintenanceResource{now: func() time.Time { return now }}
+
+	schedule, diags := types.ObjectValueFrom(ctx, dataModels.MaintenanceScheduleObjectType.AttrTypes,
+		testMaintenanceScheduleModel([]string{"monday", "wednesday"}, "22:00", "Europe/Istanbul", "4h"))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	plan := dataModels.MaintenanceModel{
+		Schedule:  schedule,
+		StartDate: timetypes.NewRFC3339Unknown(),
+		EndDate:   timetypes.NewRFC3339Unknown(),
+		NextStart: timetypes.NewRFC3339Unknown(),
+	}
+	if diags := r.setScheduledWindow(ctx, &plan); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := map[string]timetypes.RFC3339{
+		"start_date": timetypes.NewRFC3339ValueMust("2026-10-21T19:00:00Z"),
+		"end_date":   timetypes.NewRFC3339ValueMust("2026-10-21T23:00:00Z"),
+		"next_start": timetypes.NewRFC3339ValueMust("2026-10-26T19:00:00Z"),
+	}
+	actual := map[string]timetypes.RFC3339{
+		"start_date": plan.StartDate,
+		"end_date":   plan.EndDate,
+		"next_start": plan.NextStart,
+	}
+	for name, value := range expected {
+		if !actual[name].Equal(value) {
+			t.Errorf("expected %s %s, got %s", name, value, actual[name])
+		}
+	}
+
+	// The dates planned with the window in progress are kept
+	planned := plan
+	now = now.Add(72 * time.Hour)
+	if diags := r.setScheduledWindow(ctx, &planned); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !planned.StartDate.Equal(plan.StartDate) {
+		t.Errorf("expected the planned start date %s to be kept, got %s", plan.StartDate, planned.StartDate)
+	}
+}
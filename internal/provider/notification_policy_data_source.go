@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &NotificationPolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &NotificationPolicyDataSource{}
+)
+
+func NewNotificationPolicyDataSource() datasource.DataSource {
+	return &NotificationPolicyDataSource{}
+}
+
+// NotificationPolicyDataSource defines the data source implementation.
+type NotificationPolicyDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *NotificationPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_policy"
+}
+
+func (d *NotificationPolicyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Notification policy data source",
+		Attributes:          schemaAttributes.NotificationPolicyDataSourceAttributes,
+	}
+}
+
+func (d *NotificationPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring notification_policy_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure notification_policy_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured notification_policy_data_source")
+}
+
+func (d *NotificationPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.NotificationPolicyModel
+
+	tflog.Trace(ctx, "Reading notification policy data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read notification policy configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	baseUrl := fmt.Sprintf("/v1/teams/%s/policies", model.TeamID.ValueString())
+
+	notificationPolicyId := model.ID.ValueString()
+
+	if model.ID.IsNull() {
+		tflog.Trace(ctx, "Looking up the notification policy by name")
+
+		notificationPolicies, err := fetchAllPages[dto.NotificationPolicyDto](d.clientConfiguration, baseUrl, map[string]string{"type": "notification"})
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to list notification policies, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list notification policies, got error: %s", err))
+			return
+		}
+
+		var candidates []string
+		for _, notificationPolicy := range notificationPolicies {
+			if notificationPolicy.Name == model.Name.ValueString() {
+				candidates = append(candidates, notificationPolicy.ID)
+			}
+		}
+
+		if len(candidates) == 0 {
+			tflog.Error(ctx, "No notification policies found")
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("No notification policy named '%s' found in team %s", model.Name.ValueString(), model.TeamID.ValueString()))
+			return
+		} else if len(candidates) > 1 {
+			tflog.Error(ctx, "Multiple notification policies found")
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Multiple notification policies named '%s' found in team %s: %s. Use id to select one of them.", model.Name.ValueString(), model.TeamID.ValueString(), strings.Join(candidates, ", ")))
+			return
+		}
+
+		notificationPolicyId = candidates[0]
+	}
+
+	tflog.Trace(ctx, "Sending HTTP request to JSM OPS API")
+
+	var notificationPolicyDto dto.NotificationPolicyDto
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(d.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s", baseUrl, notificationPolicyId)).
+		Method(httpClient.GET).
+		SetBodyParseObject(&notificationPolicyDto).
+		Send()
+
+	if httpResp == nil {
+		tflog.Error(ctx, "Client Error. Unable to read notification policy, got nil response")
+		resp.Diagnostics.AddError("Client Error", "Unable to read notification policy, got nil response")
+	} else if httpResp.IsError() {
+		statusCode := httpResp.GetStatusCode()
+		errorResponse := httpResp.GetErrorBody()
+		if errorResponse != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read notification policy, status code: %d. Got response: %s", statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read notification policy, status code: %d. Got response: %s", statusCode, *errorResponse))
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read notification policy, got http response: %d", statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read notification policy, got http response: %d", statusCode))
+		}
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read notification policy, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read notification policy or to parse received data, got error: %s", err))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP request to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
+	result, diags := NotificationPolicyDtoToModel(ctx, &notificationPolicyDto)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Successfully read notification policy data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
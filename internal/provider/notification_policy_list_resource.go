@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &NotificationPolicyListResource{}
+var _ list.ListResourceWithConfigure = &NotificationPolicyListResource{}
+
+func NewNotificationPolicyListResource() list.ListResource {
+	return &NotificationPolicyListResource{}
+}
+
+// NotificationPolicyListResource lists the notification policies of a team.
+// The metadata, configuration and read logic are shared with NotificationPolicyResource.
+type NotificationPolicyListResource struct {
+	NotificationPolicyResource
+}
+
+func (r *NotificationPolicyListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.TeamScopedListResourceAttributes,
+	}
+}
+
+func (r *NotificationPolicyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data dataModels.TeamScopedListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Trace(ctx, "Listing notification policies")
+
+	notificationPolicies, err := fetchAllPages[dto.NotificationPolicyDto](r.clientConfiguration, fmt.Sprintf("/v1/teams/%s/policies", data.TeamId.ValueString()), map[string]string{"type": "notification"})
+	if err != nil {
+		stream.Results = listError(ctx, "Unable to list notification policies", err)
+		return
+	}
+
+	listed := make([]listedResource, 0, len(notificationPolicies))
+	for _, item := range notificationPolicies {
+		listed = append(listed, listedResource{
+			displayName: item.Name,
+			identity: map[string]types.String{
+				"id":      types.StringValue(item.ID),
+				"team_id": data.TeamId,
+			},
+		})
+	}
+
+	stream.Results = listResults(ctx, req, &r.NotificationPolicyResource, listed)
+}
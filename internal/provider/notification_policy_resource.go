@@ -12,6 +12,7 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,6 +21,7 @@ var (
 	_ resource.Resource                = &NotificationPolicyResource{}
 	_ resource.ResourceWithConfigure   = &NotificationPolicyResource{}
 	_ resource.ResourceWithImportState = &NotificationPolicyResource{}
+	_ resource.ResourceWithIdentity    = &NotificationPolicyResource{}
 )
 
 type NotificationPolicyResource struct {
@@ -40,6 +42,12 @@ func (r *NotificationPolicyResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+func (r *NotificationPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.TeamScopedIdentityAttributes,
+	}
+}
+
 func (r *NotificationPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring NotificationPolicyResource")
 
@@ -109,6 +117,7 @@ func (r *NotificationPolicyResource) Create(ctx context.Context, req resource.Cr
 	// Update state with response
 	result, _ := NotificationPolicyDtoToModel(ctx, notificationPolicyDto)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NotificationPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -155,6 +164,7 @@ func (r *NotificationPolicyResource) Read(ctx context.Context, req resource.Read
 
 	result, _ := NotificationPolicyDtoToModel(ctx, &notificationPolicyDto)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NotificationPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -203,6 +213,7 @@ func (r *NotificationPolicyResource) Update(ctx context.Context, req resource.Up
 
 	result, _ := NotificationPolicyDtoToModel(ctx, notificationPolicyDto)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NotificationPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -245,11 +256,32 @@ func (r *NotificationPolicyResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *NotificationPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
+	if team, name, found := splitNameImportId(req.ID); found {
+		// Imported by name, the team being given by ID or by name
+		teamId, id, err := resolveTeamScopedName(r.clientConfiguration, "notification policy", team, name, "/v1/teams/%s/policies", map[string]string{"type": "notification"}, func(item dto.NotificationPolicyDto) (string, string) {
+			return item.Name, item.ID
+		})
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Unable to import notification policy, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import notification policy, got error: %s", err))
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamId)...)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: id,team_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: id,team_id; or: team/name (team being the ID or the name of the team). Got: %q", req.ID),
 		)
 		return
 	}
@@ -144,6 +144,18 @@ resource "atlassian-operations_notification_policy" "test" {
 						nil
 				},
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "atlassian-operations_notification_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_notification_policy.test"].Primary.Attributes["team_id"] +
+							"/" +
+							state.RootModule().Resources["atlassian-operations_notification_policy.test"].Primary.Attributes["name"],
+						nil
+				},
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// resolveStepContacts sets the contact of the steps referencing a user contact with contact_id to the method and
+// recipient of that contact. When unknownOnly is set, only the steps whose contact is still unknown are resolved.
+// A step referencing a contact which does not exist is an error, and one referencing a deactivated contact a warning.
+func (r *NotificationRuleResource) resolveStepContacts(ctx context.Context, steps types.List, unknownOnly bool) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if steps.IsNull() || steps.IsUnknown() {
+		return steps, diags
+	}
+
+	elements := steps.Elements()
+	resolved := make([]attr.Value, len(elements))
+	for i, element := range elements {
+		resolved[i] = element
+
+		stepObject, ok := element.(types.Object)
+		if !ok || stepObject.IsNull() || stepObject.IsUnknown() {
+			continue
+		}
+
+		var step dataModels.NotificationRuleStepModel
+		diags.Append(stepObject.As(ctx, &step, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return steps, diags
+		}
+		if step.ContactId.IsNull() || step.ContactId.IsUnknown() || (unknownOnly && !step.Contact.IsUnknown()) {
+			continue
+		}
+
+		contactIdPath := path.Root("steps").AtListIndex(i).AtName("contact_id")
+		contact, found := r.fetchUserContact(ctx, step.ContactId.ValueString(), &diags)
+		if diags.HasError() {
+			return steps, diags
+		}
+		if !found {
+			diags.AddAttributeError(contactIdPath, "Invalid Attribute",
+				fmt.Sprintf("The user contact %s does not exist", step.ContactId.ValueString()))
+			continue
+		}
+		if !contact.Status.Enabled {
+			diags.AddAttributeWarning(contactIdPath, "Deactivated Contact",
+				fmt.Sprintf("The user contact %s (%s: %s) is deactivated, no notification will be sent for this step until it is activated",
+					contact.ID, contact.Method, contact.To))
+		}
+
+		step.Contact = dataModels.NotificationContactModel{
+			Method: types.StringValue(contact.Method),
+			To:     types.StringValue(contact.To),
+		}.AsValue()
+		resolved[i] = step.AsValue()
+	}
+
+	resolvedSteps, listDiags := types.ListValue(steps.ElementType(ctx), resolved)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return steps, diags
+	}
+	return resolvedSteps, diags
+}
+
+// fetchUserContact reads a user contact, and tells whether it was found.
+func (r *NotificationRuleResource) fetchUserContact(ctx context.Context, id string, diags *diag.Diagnostics) (dto.UserContactDataReadResponseDto, bool) {
+	var contact dto.UserContactDataReadResponseDto
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/users/contacts/%s", id)).
+		Method(httpClient.GET).
+		SetBodyParseObject(&contact).
+		Send()
+
+	if httpResp != nil && httpResp.IsError() && httpResp.GetStatusCode() == 404 {
+		return contact, false
+	}
+
+	var readDiags diag.Diagnostics
+	handleHttpResponse(httpResp, err, "read user contact", &readDiags, ctx)
+	diags.Append(readDiags...)
+	return contact, !readDiags.HasError()
+}
+
+// copyStepContactIds copies the contact_id of the steps of from to the steps at the same index, as the API only
+// returns the method and recipient of the contacts.
+func copyStepContactIds(ctx context.Context, steps types.List, from types.List) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if steps.IsNull() || steps.IsUnknown() || from.IsNull() || from.IsUnknown() {
+		return steps, diags
+	}
+
+	fromElements := from.Elements()
+	elements := steps.Elements()
+	copied := make([]attr.Value, len(elements))
+	for i, element := range elements {
+		copied[i] = element
+		if i >= len(fromElements) {
+			continue
+		}
+
+		stepObject, ok := element.(types.Object)
+		fromObject, fromOk := fromElements[i].(types.Object)
+		if !ok || !fromOk || stepObject.IsNull() || fromObject.IsNull() || fromObject.IsUnknown() {
+			continue
+		}
+		contactId, ok := fromObject.Attributes()["contact_id"].(types.String)
+		if !ok || contactId.IsNull() || contactId.IsUnknown() {
+			continue
+		}
+
+		attributes := make(map[string]attr.Value, len(stepObject.Attributes()))
+		for name, value := range stepObject.Attributes() {
+			attributes[name] = value
+		}
+		attributes["contact_id"] = contactId
+
+		value, objectDiags := types.ObjectValue(dataModels.NotificationRuleStepModelMap, attributes)
+		diags.Append(objectDiags...)
+		if objectDiags.HasError() {
+			return steps, diags
+		}
+		copied[i] = value
+	}
+
+	copiedSteps, listDiags := types.ListValue(steps.ElementType(ctx), copied)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return steps, diags
+	}
+	return copiedSteps, diags
+}
@@ -11,13 +11,17 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &NotificationRuleResource{}
 var _ resource.ResourceWithImportState = &NotificationRuleResource{}
+var _ resource.ResourceWithIdentity = &NotificationRuleResource{}
+var _ resource.ResourceWithModifyPlan = &NotificationRuleResource{}
 
 func NewNotificationRuleResource() resource.Resource {
 	return &NotificationRuleResource{}
@@ -38,6 +42,12 @@ func (r *NotificationRuleResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+func (r *NotificationRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.IdIdentityAttributes,
+	}
+}
+
 func (r *NotificationRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring NotificationRuleResource")
 
@@ -58,6 +68,26 @@ func (r *NotificationRuleResource) Configure(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "Configured NotificationRuleResource")
 }
 
+// ModifyPlan resolves the contact of the steps referencing a user contact with contact_id.
+func (r *NotificationRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var steps types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("steps"), &steps)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	steps, diags := r.resolveStepContacts(ctx, steps, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("steps"), steps)...)
+}
+
 func (r *NotificationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "Creating NotificationRuleResource")
 
@@ -67,6 +97,15 @@ func (r *NotificationRuleResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	// Resolve the contacts whose ID was not known at plan time
+	steps, diags := r.resolveStepContacts(ctx, data.Steps, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Steps = steps
+	plan := data
+
 	// Convert to DTO
 	notificationRuleDto, err := NotificationRuleModelToDto(ctx, data)
 	if err != nil {
@@ -111,7 +150,10 @@ func (r *NotificationRuleResource) Create(ctx context.Context, req resource.Crea
 
 	// Update state with response
 	data = NotificationRuleDtoToModel(ctx, notificationRuleDto)
+	data.Steps, diags = copyStepContactIds(ctx, data.Steps, plan.Steps)
+	resp.Diagnostics.Append(diags...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NotificationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -156,8 +198,13 @@ func (r *NotificationRuleResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	state := data
 	data = NotificationRuleDtoToModel(ctx, notificationRuleDto)
+	steps, diags := copyStepContactIds(ctx, data.Steps, state.Steps)
+	resp.Diagnostics.Append(diags...)
+	data.Steps = steps
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NotificationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -167,6 +214,15 @@ func (r *NotificationRuleResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	// Resolve the contacts whose ID was not known at plan time
+	steps, diags := r.resolveStepContacts(ctx, data.Steps, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Steps = steps
+	plan := data
+
 	// Convert to DTO
 	notificationRuleDto, err := NotificationRuleModelToDto(ctx, data)
 	if err != nil {
@@ -210,7 +266,10 @@ func (r *NotificationRuleResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	data = NotificationRuleDtoToModel(ctx, notificationRuleDto)
+	data.Steps, diags = copyStepContactIds(ctx, data.Steps, plan.Steps)
+	resp.Diagnostics.Append(diags...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NotificationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -254,5 +313,11 @@ func (r *NotificationRuleResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -1,7 +1,9 @@
 package provider
 
 import (
+	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -113,6 +115,12 @@ resource "atlassian-operations_notification_rule" "example" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import by identity
+			{
+				ResourceName:    "atlassian-operations_notification_rule.example",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
@@ -365,3 +373,165 @@ resource "atlassian-operations_notification_rule" "example" {
 		},
 	})
 }
+
+func TestAccNotificationRuleCriteriaConditionsResource(t *testing.T) {
+	teamName := uuid.NewString()
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Operations which are not accepted by the field are rejected at plan time
+			{
+				Config: providerConfig + testAccNotificationRuleCriteriaConditionsConfig(teamName, emailPrimary, organizationId, `{
+        field          = "priority"
+        operation      = "contains"
+        expected_value = "P1"
+      }`),
+				ExpectError: regexp.MustCompile(`can't\s+be\s+used\s+with\s+the\s+field\s+'priority'`),
+			},
+			{
+				Config: providerConfig + testAccNotificationRuleCriteriaConditionsConfig(teamName, emailPrimary, organizationId, `{
+        field          = "tags"
+        operation      = "is-empty"
+        expected_value = "critical"
+      }`),
+				ExpectError: regexp.MustCompile(`must\s+not\s+be\s+set\s+for\s+the\s+'is-empty'\s+operation`),
+			},
+			// Create with the order of the conditions defaulting to their index
+			{
+				Config: providerConfig + testAccNotificationRuleCriteriaConditionsConfig(teamName, emailPrimary, organizationId, `{
+        field          = "message"
+        operation      = "contains"
+        expected_value = "critical"
+      },
+      {
+        field     = "tags"
+        operation = "is-empty"
+      }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_notification_rule.example", "criteria.conditions.#", "2"),
+					resource.TestCheckResourceAttr("atlassian-operations_notification_rule.example", "criteria.conditions.0.order", "0"),
+					resource.TestCheckResourceAttr("atlassian-operations_notification_rule.example", "criteria.conditions.1.operation", "is-empty"),
+					resource.TestCheckResourceAttr("atlassian-operations_notification_rule.example", "criteria.conditions.1.order", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNotificationRuleCriteriaConditionsConfig(teamName string, emailPrimary string, organizationId string, conditions string) string {
+	return `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_notification_rule" "example" {
+  name        = "Critical Incident Alert"
+  action_type = "create-alert"
+  enabled     = true
+
+  criteria = {
+    type = "match-any-condition"
+    conditions = [
+      ` + conditions + `
+    ]
+  }
+
+  steps = [
+    {
+      send_after = 15
+      enabled    = true
+      contact = {
+        method = "email"
+        to     = "` + emailPrimary + `"
+      }
+    }
+  ]
+}
+`
+}
+
+func TestAccNotificationRuleStepContactIdResource(t *testing.T) {
+	contactEmail := "notification-rule-" + uuid.NewString() + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Steps referencing a contact which does not exist are rejected at plan time
+			{
+				Config:      providerConfig + testAccNotificationRuleStepContactIdConfig(contactEmail, true, `"`+uuid.NewString()+`"`),
+				ExpectError: regexp.MustCompile(`The\s+user\s+contact\s+\S+\s+does\s+not\s+exist`),
+			},
+			// Create with the method and recipient resolved from the contact
+			{
+				Config: providerConfig + testAccNotificationRuleStepContactIdConfig(contactEmail, true, "atlassian-operations_user_contact.example.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("atlassian-operations_notification_rule.example", "steps.0.contact_id", "atlassian-operations_user_contact.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_notification_rule.example", "steps.0.contact.method", "email"),
+					resource.TestCheckResourceAttr("atlassian-operations_notification_rule.example", "steps.0.contact.to", contactEmail),
+				),
+			},
+			// Deactivating the contact keeps the step, with a warning
+			{
+				Config: providerConfig + testAccNotificationRuleStepContactIdConfig(contactEmail, false, "atlassian-operations_user_contact.example.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_user_contact.example", "enabled", "false"),
+					resource.TestCheckResourceAttr("atlassian-operations_notification_rule.example", "steps.0.contact.to", contactEmail),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNotificationRuleStepContactIdConfig(contactEmail string, contactEnabled bool, contactId string) string {
+	return fmt.Sprintf(`
+resource "atlassian-operations_user_contact" "example" {
+  method  = "email"
+  to      = "%s"
+  enabled = %t
+}
+
+resource "atlassian-operations_notification_rule" "example" {
+  name        = "Critical Incident Alert"
+  action_type = "create-alert"
+  enabled     = true
+
+  criteria = {
+    type = "match-all"
+  }
+
+  steps = [
+    {
+      send_after = 15
+      enabled    = true
+      contact_id = %s
+    }
+  ]
+}
+`, contactEmail, contactEnabled, contactId)
+}
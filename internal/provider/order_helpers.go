@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+)
+
+// reorderIds moves the desired IDs to the front of current, in the given order, calling move for every ID whose
+// index has to change. IDs of current which are not in desired keep their relative order after the desired ones.
+func reorderIds(current []string, desired []string, move func(id string, order int) error) error {
+	ids := make([]string, len(current))
+	copy(ids, current)
+
+	for order, id := range desired {
+		index := indexOf(ids, id)
+		if index == -1 {
+			return fmt.Errorf("%s could not be found", id)
+		}
+		if index == order {
+			continue
+		}
+
+		if err := move(id, order); err != nil {
+			return err
+		}
+
+		ids = append(ids[:index], ids[index+1:]...)
+		ids = append(ids[:order], append([]string{id}, ids[order:]...)...)
+	}
+
+	return nil
+}
+
+// managedOrder returns the IDs at the positions the managed IDs are placed at by reorderIds, i.e. the first
+// len(managed) IDs of current, so that an ID which is not managed but moved before the managed ones shows up as a
+// difference, as well as a change in the relative order of the managed IDs.
+func managedOrder(current []string, managed []string) []string {
+	ids := make([]string, min(len(current), len(managed)))
+	copy(ids, current)
+	return ids
+}
+
+func indexOf(ids []string, id string) int {
+	for i, value := range ids {
+		if value == id {
+			return i
+		}
+	}
+	return -1
+}
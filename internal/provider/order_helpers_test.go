@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestManagedOrder(t *testing.T) {
+	testCases := []struct {
+		name     string
+		current  []string
+		managed  []string
+		expected []string
+	}{
+		{
+			name:     "managed IDs in order",
+			current:  []string{"a", "b", "c"},
+			managed:  []string{"a", "b"},
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "managed IDs swapped",
+			current:  []string{"b", "a", "c"},
+			managed:  []string{"a", "b"},
+			expected: []string{"b", "a"},
+		},
+		{
+			name:     "unmanaged ID moved before the managed ones",
+			current:  []string{"c", "a", "b"},
+			managed:  []string{"a", "b"},
+			expected: []string{"c", "a"},
+		},
+		{
+			name:     "managed ID deleted",
+			current:  []string{"a"},
+			managed:  []string{"a", "b"},
+			expected: []string{"a"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if ids := managedOrder(testCase.current, testCase.managed); !slices.Equal(ids, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, ids)
+			}
+		})
+	}
+}
+
+func TestReorderIds(t *testing.T) {
+	var moves []string
+	err := reorderIds([]string{"c", "a", "b"}, []string{"a", "b"}, func(id string, order int) error {
+		moves = append(moves, id)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !slices.Equal(moves, []string{"a", "b"}) {
+		t.Errorf("expected the managed IDs to be moved before the unmanaged one, got moves %v", moves)
+	}
+
+	if err := reorderIds([]string{"a"}, []string{"a", "b"}, func(string, int) error { return nil }); err == nil {
+		t.Error("expected an error for an ID which could not be found")
+	}
+}
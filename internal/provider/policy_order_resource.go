@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"sort"
+	"strings"
+)
+
+var _ resource.Resource = &PolicyOrderResource{}
+var _ resource.ResourceWithImportState = &PolicyOrderResource{}
+var _ resource.ResourceWithIdentity = &PolicyOrderResource{}
+var _ resource.ResourceWithValidateConfig = &PolicyOrderResource{}
+
+func NewPolicyOrderResource() resource.Resource {
+	return &PolicyOrderResource{}
+}
+
+type PolicyOrderResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (r *PolicyOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_order"
+}
+
+func (r *PolicyOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.PolicyOrderResourceAttributes,
+	}
+}
+
+func (r *PolicyOrderResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.PolicyOrderIdentityAttributes,
+	}
+}
+
+func (r *PolicyOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+}
+
+func (r *PolicyOrderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.PolicyOrderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.PolicyType.ValueString() == "notification" && data.TeamId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("team_id"),
+			"Missing Team ID",
+			"Notification policies only exist within a team, team_id must be set when policy_type is 'notification'.",
+		)
+	}
+}
+
+func (r *PolicyOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data dataModels.PolicyOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyOrder(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *PolicyOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dataModels.PolicyOrderModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentOrder, err := r.fetchOrder(data)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read %s policies, %s", data.PolicyType.ValueString(), err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s policies, %s", data.PolicyType.ValueString(), err))
+		return
+	}
+
+	policyIds := currentOrder
+	if !data.PolicyIds.IsNull() {
+		// Only the leading policies, at the positions of the managed ones, are tracked, so that reordering them outside of
+		// Terraform or moving another policy before them shows up as a diff
+		var managedIds []string
+		resp.Diagnostics.Append(data.PolicyIds.ElementsAs(ctx, &managedIds, false)...)
+		policyIds = managedOrder(currentOrder, managedIds)
+	}
+
+	policyIdsValue, diags := types.ListValueFrom(ctx, types.StringType, policyIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(policyOrderId(data))
+	data.PolicyIds = policyIdsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *PolicyOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data dataModels.PolicyOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyOrder(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *PolicyOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The policies keep their current order, only the resource is removed from the state
+	tflog.Trace(ctx, "Deleted the PolicyOrderResource, the order of the policies is left as is")
+}
+
+func (r *PolicyOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) > 2 || idParts[0] == "" || (len(idParts) == 2 && idParts[1] == "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: policy_type,team_id or policy_type for global alert policies. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_type"), idParts[0])...)
+	if len(idParts) == 2 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), idParts[1])...)
+	}
+}
+
+func (r *PolicyOrderResource) applyOrder(ctx context.Context, data *dataModels.PolicyOrderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var policyIds []string
+	diags.Append(data.PolicyIds.ElementsAs(ctx, &policyIds, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	currentOrder, err := r.fetchOrder(*data)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read %s policies, %s", data.PolicyType.ValueString(), err))
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read %s policies, %s", data.PolicyType.ValueString(), err))
+		return diags
+	}
+
+	err = reorderIds(currentOrder, policyIds, func(id string, order int) error {
+		tflog.Trace(ctx, fmt.Sprintf("Moving policy %s to %d", id, order))
+
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(r.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("%s/%s/change-order", policiesBaseUrl(*data), id)).
+			Method(httpClient.POST).
+			SetBody(dto.PolicyChangeOrderDto{TargetIndex: order}).
+			Send()
+
+		handleHttpResponse(httpResp, err, fmt.Sprintf("change the order of policy %s", id), &diags, ctx)
+		if diags.HasError() {
+			return fmt.Errorf("unable to change the order of policy %s", id)
+		}
+		return nil
+	})
+	if err != nil && !diags.HasError() {
+		diags.AddAttributeError(path.Root("policy_ids"), "Invalid Policy", fmt.Sprintf("Unable to order the %s policies, policy %s", data.PolicyType.ValueString(), err))
+	}
+
+	data.Id = types.StringValue(policyOrderId(*data))
+	return diags
+}
+
+// fetchOrder returns the IDs of the policies of the given type, in their current order.
+func (r *PolicyOrderResource) fetchOrder(data dataModels.PolicyOrderModel) ([]string, error) {
+	policies, err := fetchAllPages[dto.PolicyOrderDto](r.clientConfiguration, policiesBaseUrl(data), map[string]string{"type": data.PolicyType.ValueString()})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(policies, func(i, j int) bool {
+		return policies[i].Order < policies[j].Order
+	})
+
+	ids := make([]string, len(policies))
+	for i, policy := range policies {
+		ids[i] = policy.ID
+	}
+	return ids, nil
+}
+
+func policiesBaseUrl(data dataModels.PolicyOrderModel) string {
+	if data.TeamId.IsNull() || data.TeamId.IsUnknown() {
+		return "/v1/alerts/policies"
+	}
+	return fmt.Sprintf("/v1/teams/%s/policies", data.TeamId.ValueString())
+}
+
+func policyOrderId(data dataModels.PolicyOrderModel) string {
+	if data.TeamId.IsNull() || data.TeamId.IsUnknown() {
+		return data.PolicyType.ValueString()
+	}
+	return fmt.Sprintf("%s,%s", data.PolicyType.ValueString(), data.TeamId.ValueString())
+}
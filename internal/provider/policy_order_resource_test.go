@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPolicyOrderResource(t *testing.T) {
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	config := func(policyIds string) string {
+		return providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_alert_policy" "first" {
+  name    = "First Alert Policy"
+  team_id = atlassian-operations_team.example.id
+  type    = "alert"
+  enabled = true
+  message = "{{message}}"
+}
+
+resource "atlassian-operations_alert_policy" "second" {
+  name    = "Second Alert Policy"
+  team_id = atlassian-operations_team.example.id
+  type    = "alert"
+  enabled = true
+  message = "{{message}}"
+}
+
+resource "atlassian-operations_policy_order" "example" {
+  team_id     = atlassian-operations_team.example.id
+  policy_type = "alert"
+  policy_ids  = ` + policyIds + `
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Notification policies can only be ordered within a team
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_policy_order" "example" {
+  policy_type = "notification"
+  policy_ids  = ["xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing Team ID"),
+			},
+			// Create and Read testing
+			{
+				Config: config("[atlassian-operations_alert_policy.second.id, atlassian-operations_alert_policy.first.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_policy_order.example", "policy_type", "alert"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_policy_order.example", "team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_policy_order.example", "policy_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_policy_order.example", "policy_ids.0", "atlassian-operations_alert_policy.second", "id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_policy_order.example", "policy_ids.1", "atlassian-operations_alert_policy.first", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_policy_order.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Import by identity
+			{
+				ResourceName:    "atlassian-operations_policy_order.example",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			// Update and Read testing
+			{
+				Config: config("[atlassian-operations_alert_policy.first.id, atlassian-operations_alert_policy.second.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("atlassian-operations_policy_order.example", "policy_ids.0", "atlassian-operations_alert_policy.first", "id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_policy_order.example", "policy_ids.1", "atlassian-operations_alert_policy.second", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
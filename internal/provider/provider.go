@@ -8,7 +8,10 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -19,7 +22,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &atlassianOpsProvider{}
+	_ provider.Provider                  = &atlassianOpsProvider{}
+	_ provider.ProviderWithActions       = &atlassianOpsProvider{}
+	_ provider.ProviderWithFunctions     = &atlassianOpsProvider{}
+	_ provider.ProviderWithListResources = &atlassianOpsProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -91,6 +97,7 @@ func (p *atlassianOpsProvider) Configure(ctx context.Context, req provider.Confi
 	}
 	orgAdminToken := os.Getenv("ATLASSIAN_OPS_API_ORG_ADMIN_TOKEN")
 	token := os.Getenv("ATLASSIAN_OPS_API_TOKEN")
+	organizationId := os.Getenv("ATLASSIAN_OPS_ORGANIZATION_ID")
 
 	if productType == "" {
 		if config.ProductType.IsNull() {
@@ -160,11 +167,16 @@ func (p *atlassianOpsProvider) Configure(ctx context.Context, req provider.Confi
 		}
 	}
 
+	if organizationId == "" {
+		organizationId = config.OrganizationId.ValueString()
+	}
+
 	ctx = tflog.SetField(ctx, "atlassian-operations_product_type", productType)
 	ctx = tflog.SetField(ctx, "atlassian-operations_cloud_id", cloudId)
 	ctx = tflog.SetField(ctx, "atlassian-operations_domain_name", domainName)
 	ctx = tflog.SetField(ctx, "atlassian-operations_email_address", emailAddress)
 	ctx = tflog.SetField(ctx, "atlassian-operations_org_admin_token", orgAdminToken)
+	ctx = tflog.SetField(ctx, "atlassian-operations_organization_id", organizationId)
 	ctx = tflog.SetField(ctx, "atlassian-operations_token", token)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "atlassian-operations_token")
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "atlassian-operations_org_admin_token")
@@ -179,16 +191,19 @@ func (p *atlassianOpsProvider) Configure(ctx context.Context, req provider.Confi
 		emailAddress,
 		token,
 		orgAdminToken,
+		organizationId,
 		int(config.ApiRetryCount.ValueInt32()),
 		time.Duration(config.ApiRetryWait.ValueInt32())*time.Second,
 		time.Duration(config.ApiRetryWaitMax.ValueInt32())*time.Second,
 		isStaging,
 	)
 
-	// Make the atlassian-operations clientConfiguration available during DataSource and Resource
+	// Make the atlassian-operations clientConfiguration available during DataSource, Resource, Action and ListResource
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ActionData = client
+	resp.ListResourceData = client
 
 	tflog.Info(ctx, "Configured atlassian-operations clientConfiguration", map[string]any{"success": true})
 }
@@ -199,6 +214,13 @@ func (p *atlassianOpsProvider) DataSources(_ context.Context) []func() datasourc
 		NewUserDataSource,
 		NewTeamDataSource,
 		NewScheduleDataSource,
+		NewEscalationDataSource,
+		NewRoutingRuleDataSource,
+		NewAlertPolicyDataSource,
+		NewNotificationPolicyDataSource,
+		NewIntegrationDataSource,
+		NewHeartbeatDataSource,
+		NewHeartbeatsDataSource,
 	}
 }
 
@@ -208,17 +230,57 @@ func (p *atlassianOpsProvider) Resources(_ context.Context) []func() resource.Re
 		NewScheduleRotationResource,
 		NewScheduleResource,
 		NewTeamResource,
+		NewTeamMemberResource,
 		NewEscalationResource,
 		NewEmailIntegrationResource,
+		NewWebhookIntegrationResource,
 		NewApiIntegrationResource,
+		NewIntegrationResource,
 		NewRoutingRuleResource,
+		NewTeamRoutingRuleOrderResource,
 		NewNotificationRuleResource,
 		NewUserContactResource,
 		NewAlertPolicyResource,
 		NewCustomRoleResource,
 		NewNotificationPolicyResource,
+		NewPolicyOrderResource,
 		NewHeartbeatResource,
 		NewIntegrationActionResource,
 		NewMaintenanceResource,
+		NewUserNotificationProfileResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *atlassianOpsProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewTeamListResource,
+		NewScheduleListResource,
+		NewScheduleRotationListResource,
+		NewEscalationListResource,
+		NewRoutingRuleListResource,
+		NewAlertPolicyListResource,
+		NewNotificationPolicyListResource,
+		NewHeartbeatListResource,
+		NewApiIntegrationListResource,
+		NewEmailIntegrationListResource,
+		NewMaintenanceListResource,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *atlassianOpsProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewBusinessHoursFunction,
+		NewConditionFunction,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *atlassianOpsProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewHeartbeatPingAction,
+		NewMaintenanceCancelAction,
+		NewSendTestAlertAction,
 	}
 }
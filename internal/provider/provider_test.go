@@ -1,11 +1,18 @@
 package provider
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 const (
@@ -39,3 +46,55 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
 	}
 }
+
+// testAccClientConfiguration returns the client configuration of the provider configured by providerConfig, to call
+// the API directly from tests, such as to change resources outside of Terraform.
+func testAccClientConfiguration() dto.AtlassianOpsProviderModel {
+	productType := os.Getenv("ATLASSIAN_OPS_PRODUCT_TYPE")
+	if productType == "" {
+		productType = "jira-service-desk"
+	}
+	emailAddress := os.Getenv("ATLASSIAN_OPS_API_EMAIL_ADDRESS")
+	if emailAddress == "" {
+		emailAddress = os.Getenv("ATLASSIAN_OPS_API_USERNAME")
+	}
+
+	return dto.NewAtlassianOpsProviderModel(
+		productType,
+		os.Getenv("ATLASSIAN_OPS_CLOUD_ID"),
+		os.Getenv("ATLASSIAN_OPS_DOMAIN_NAME"),
+		emailAddress,
+		os.Getenv("ATLASSIAN_OPS_API_TOKEN"),
+		os.Getenv("ATLASSIAN_OPS_API_ORG_ADMIN_TOKEN"),
+		os.Getenv("ATLASSIAN_OPS_ORGANIZATION_ID"),
+		5,
+		15*time.Second,
+		100*time.Second,
+		os.Getenv("ATLASSIAN_OPS_STAGING") == "1",
+	)
+}
+
+var _ config.Variable = &testAccStateVariable{}
+
+// testAccStateVariable is a configuration variable holding an attribute of a resource created by a previous step.
+// Query steps cannot reference the resources of the configuration, so the IDs they list objects for are passed as
+// variables. The value is only encoded when the variables of the step are written.
+type testAccStateVariable struct {
+	value string
+}
+
+// capture returns a check saving the attribute of the given resource as the value of the variable.
+func (v *testAccStateVariable) capture(resourceName string, attribute string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		r, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		v.value = r.Primary.Attributes[attribute]
+		return nil
+	}
+}
+
+func (v *testAccStateVariable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &RoutingRuleDataSource{}
+	_ datasource.DataSourceWithConfigure = &RoutingRuleDataSource{}
+)
+
+func NewRoutingRuleDataSource() datasource.DataSource {
+	return &RoutingRuleDataSource{}
+}
+
+// RoutingRuleDataSource defines the data source implementation.
+type RoutingRuleDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *RoutingRuleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_rule"
+}
+
+func (d *RoutingRuleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Routing rule data source",
+		Attributes:          schemaAttributes.RoutingRuleDataSourceAttributes,
+	}
+}
+
+func (d *RoutingRuleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring routing_rule_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure routing_rule_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured routing_rule_data_source")
+}
+
+func (d *RoutingRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.RoutingRuleModel
+
+	tflog.Trace(ctx, "Reading routing rule data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read routing rule configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	teamId := model.TeamID.ValueString()
+	routingRuleId := model.ID.ValueString()
+
+	if model.ID.IsNull() {
+		tflog.Trace(ctx, "Looking up the routing rule by name")
+
+		routingRules, err := fetchAllPages[dto.RoutingRuleDto](d.clientConfiguration, fmt.Sprintf("/v1/teams/%s/routing-rules", teamId), nil)
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to list routing rules, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list routing rules, got error: %s", err))
+			return
+		}
+
+		var candidates []string
+		for _, routingRule := range routingRules {
+			if routingRule.Name == model.Name.ValueString() {
+				candidates = append(candidates, routingRule.ID)
+			}
+		}
+
+		if len(candidates) == 0 {
+			tflog.Error(ctx, "No routing rules found")
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("No routing rule named '%s' found in team %s", model.Name.ValueString(), teamId))
+			return
+		} else if len(candidates) > 1 {
+			tflog.Error(ctx, "Multiple routing rules found")
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Multiple routing rules named '%s' found in team %s: %s. Use id to select one of them.", model.Name.ValueString(), teamId, strings.Join(candidates, ", ")))
+			return
+		}
+
+		routingRuleId = candidates[0]
+	}
+
+	tflog.Trace(ctx, "Sending HTTP request to JSM OPS API")
+
+	routingRuleDto := dto.RoutingRuleDto{}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(d.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/routing-rules/%s", teamId, routingRuleId)).
+		Method(httpClient.GET).
+		SetBodyParseObject(&routingRuleDto).
+		Send()
+
+	if httpResp == nil {
+		tflog.Error(ctx, "Client Error. Unable to read routing rule, got nil response")
+		resp.Diagnostics.AddError("Client Error", "Unable to read routing rule, got nil response")
+	} else if httpResp.IsError() {
+		statusCode := httpResp.GetStatusCode()
+		errorResponse := httpResp.GetErrorBody()
+		if errorResponse != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read routing rule, status code: %d. Got response: %s", statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read routing rule, status code: %d. Got response: %s", statusCode, *errorResponse))
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read routing rule, got http response: %d", statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read routing rule, got http response: %d", statusCode))
+		}
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read routing rule, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read routing rule or to parse received data, got error: %s", err))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP request to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
+	model = RoutingRuleDtoToModel(teamId, routingRuleDto)
+
+	tflog.Trace(ctx, "Successfully read routing rule data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoutingRuleDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	routingRuleName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_routing_rule" "example" {
+  name    = "` + routingRuleName + `"
+  team_id = atlassian-operations_team.example.id
+  order = 0
+  criteria = {
+    type = "match-any-condition"
+    conditions = [
+      {
+        field          = "message"
+        operation      = "contains"
+        expected_value = "critical"
+      }
+    ]
+  }
+  notify = {
+    type = "none"
+  }
+}
+
+data "atlassian-operations_routing_rule" "by_name" {
+	depends_on = ["atlassian-operations_routing_rule.example"]
+	team_id = atlassian-operations_team.example.id
+	name = "` + routingRuleName + `"
+}
+
+data "atlassian-operations_routing_rule" "by_id" {
+	team_id = atlassian-operations_team.example.id
+	id = atlassian-operations_routing_rule.example.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_name", "id", "atlassian-operations_routing_rule.example", "id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_name", "name", "atlassian-operations_routing_rule.example", "name"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_name", "team_id", "atlassian-operations_routing_rule.example", "team_id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_name", "order", "atlassian-operations_routing_rule.example", "order"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_name", "is_default", "atlassian-operations_routing_rule.example", "is_default"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_name", "timezone", "atlassian-operations_routing_rule.example", "timezone"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_name", "criteria.type", "atlassian-operations_routing_rule.example", "criteria.type"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_name", "criteria.conditions.#", "atlassian-operations_routing_rule.example", "criteria.conditions.#"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_name", "notify.type", "atlassian-operations_routing_rule.example", "notify.type"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_id", "id", "atlassian-operations_routing_rule.example", "id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_id", "name", "atlassian-operations_routing_rule.example", "name"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_id", "team_id", "atlassian-operations_routing_rule.example", "team_id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_id", "order", "atlassian-operations_routing_rule.example", "order"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_id", "is_default", "atlassian-operations_routing_rule.example", "is_default"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_id", "timezone", "atlassian-operations_routing_rule.example", "timezone"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_id", "criteria.type", "atlassian-operations_routing_rule.example", "criteria.type"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_id", "criteria.conditions.#", "atlassian-operations_routing_rule.example", "criteria.conditions.#"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_routing_rule.by_id", "notify.type", "atlassian-operations_routing_rule.example", "notify.type"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &RoutingRuleListResource{}
+var _ list.ListResourceWithConfigure = &RoutingRuleListResource{}
+
+func NewRoutingRuleListResource() list.ListResource {
+	return &RoutingRuleListResource{}
+}
+
+// RoutingRuleListResource lists the routing rules of a team.
+// The metadata, configuration and read logic are shared with RoutingRuleResource.
+type RoutingRuleListResource struct {
+	RoutingRuleResource
+}
+
+func (r *RoutingRuleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.TeamScopedListResourceAttributes,
+	}
+}
+
+func (r *RoutingRuleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data dataModels.TeamScopedListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Trace(ctx, "Listing routing rules")
+
+	routingRules, err := fetchAllPages[dto.RoutingRuleDto](r.clientConfiguration, fmt.Sprintf("/v1/teams/%s/routing-rules", data.TeamId.ValueString()), nil)
+	if err != nil {
+		stream.Results = listError(ctx, "Unable to list routing rules", err)
+		return
+	}
+
+	listed := make([]listedResource, 0, len(routingRules))
+	for _, item := range routingRules {
+		listed = append(listed, listedResource{
+			displayName: item.Name,
+			identity: map[string]types.String{
+				"id":      types.StringValue(item.ID),
+				"team_id": data.TeamId,
+			},
+		})
+	}
+
+	stream.Results = listResults(ctx, req, &r.RoutingRuleResource, listed)
+}
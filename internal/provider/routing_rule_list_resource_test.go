@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccRoutingRuleListResource(t *testing.T) {
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	teamId := &testAccStateVariable{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		// List resources are only supported from Terraform 1.14 onwards
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the routing rules to list
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_routing_rule" "first" {
+  team_id  = atlassian-operations_team.example.id
+  name     = "First Listed Routing Rule"
+  timezone = "Europe/Istanbul"
+
+  criteria = {
+    type = "match-all"
+  }
+
+  notify = {
+    type = "none"
+  }
+}
+
+resource "atlassian-operations_routing_rule" "second" {
+  team_id  = atlassian-operations_team.example.id
+  name     = "Second Listed Routing Rule"
+  timezone = "Europe/Istanbul"
+
+  criteria = {
+    type = "match-all"
+  }
+
+  notify = {
+    type = "none"
+  }
+}
+`,
+				Check: teamId.capture("atlassian-operations_team.example", "id"),
+			},
+			// List the routing rules of the team along with their resource, which include the default routing rule of
+			// the team
+			{
+				Query: true,
+				Config: `
+variable "team_id" {
+  type = string
+}
+
+list "atlassian-operations_routing_rule" "test" {
+  provider         = atlassian-operations
+  include_resource = true
+
+  config {
+    team_id = var.team_id
+  }
+}
+`,
+				ConfigVariables: config.Variables{
+					"team_id": teamId,
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("atlassian-operations_routing_rule.test", 2),
+					querycheck.ExpectResourceKnownValues("atlassian-operations_routing_rule.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact("First Listed Routing Rule")), []querycheck.KnownValueCheck{
+							{Path: tfjsonpath.New("name"), KnownValue: knownvalue.StringExact("First Listed Routing Rule")},
+							{Path: tfjsonpath.New("timezone"), KnownValue: knownvalue.StringExact("Europe/Istanbul")},
+							{Path: tfjsonpath.New("notify").AtMapKey("type"), KnownValue: knownvalue.StringExact("none")},
+						}),
+					querycheck.ExpectResourceDisplayName("atlassian-operations_routing_rule.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact("Second Listed Routing Rule")), knownvalue.StringExact("Second Listed Routing Rule")),
+				},
+			},
+			// The number of results is limited
+			{
+				Query: true,
+				Config: `
+variable "team_id" {
+  type = string
+}
+
+list "atlassian-operations_routing_rule" "test" {
+  provider         = atlassian-operations
+  include_resource = true
+  limit            = 1
+
+  config {
+    team_id = var.team_id
+  }
+}
+`,
+				ConfigVariables: config.Variables{
+					"team_id": teamId,
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("atlassian-operations_routing_rule.test", 1),
+				},
+			},
+		},
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"strings"
@@ -18,6 +19,7 @@ import (
 
 var _ resource.Resource = &RoutingRuleResource{}
 var _ resource.ResourceWithImportState = &RoutingRuleResource{}
+var _ resource.ResourceWithIdentity = &RoutingRuleResource{}
 
 func NewRoutingRuleResource() resource.Resource {
 	return &RoutingRuleResource{}
@@ -37,6 +39,12 @@ func (r *RoutingRuleResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *RoutingRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.TeamScopedIdentityAttributes,
+	}
+}
+
 func (r *RoutingRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -78,6 +86,7 @@ func (r *RoutingRuleResource) Create(ctx context.Context, req resource.CreateReq
 	// Update state with response
 	data = RoutingRuleDtoToModel(data.TeamID.ValueString(), ruleDto)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *RoutingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -101,6 +110,7 @@ func (r *RoutingRuleResource) Read(ctx context.Context, req resource.ReadRequest
 	// Update state
 	data = RoutingRuleDtoToModel(data.TeamID.ValueString(), ruleDto)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *RoutingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -127,6 +137,7 @@ func (r *RoutingRuleResource) Update(ctx context.Context, req resource.UpdateReq
 	// Update state
 	data = RoutingRuleDtoToModel(data.TeamID.ValueString(), ruleDto)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *RoutingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -147,11 +158,32 @@ func (r *RoutingRuleResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *RoutingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
+	if team, name, found := splitNameImportId(req.ID); found {
+		// Imported by name, the team being given by ID or by name
+		teamId, id, err := resolveTeamScopedName(r.clientConfiguration, "routing rule", team, name, "/v1/teams/%s/routing-rules", nil, func(item dto.RoutingRuleDto) (string, string) {
+			return item.Name, item.ID
+		})
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Unable to import routing rule, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import routing rule, got error: %s", err))
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamId)...)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: id,team_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: id,team_id; or: team/name (team being the ID or the name of the team). Got: %q", req.ID),
 		)
 		return
 	}
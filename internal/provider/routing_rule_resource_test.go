@@ -134,6 +134,18 @@ resource "atlassian-operations_routing_rule" "example" {
 						nil
 				},
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "atlassian-operations_routing_rule.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_routing_rule.example"].Primary.Attributes["team_id"] +
+							"/" +
+							state.RootModule().Resources["atlassian-operations_routing_rule.example"].Primary.Attributes["name"],
+						nil
+				},
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
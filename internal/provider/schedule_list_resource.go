@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &ScheduleListResource{}
+var _ list.ListResourceWithConfigure = &ScheduleListResource{}
+
+func NewScheduleListResource() list.ListResource {
+	return &ScheduleListResource{}
+}
+
+// ScheduleListResource lists the schedules of the site.
+// The metadata, configuration and read logic are shared with ScheduleResource.
+type ScheduleListResource struct {
+	ScheduleResource
+}
+
+func (r *ScheduleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.ScheduleListResourceAttributes,
+	}
+}
+
+func (r *ScheduleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Trace(ctx, "Listing schedules")
+
+	schedules, err := fetchAllPages[dto.Schedule](r.clientConfiguration, "/v1/schedules", nil)
+	if err != nil {
+		stream.Results = listError(ctx, "Unable to list schedules", err)
+		return
+	}
+
+	listed := make([]listedResource, 0, len(schedules))
+	for _, item := range schedules {
+		listed = append(listed, listedResource{
+			displayName: item.Name,
+			identity: map[string]types.String{
+				"id": types.StringValue(item.Id),
+			},
+		})
+	}
+
+	stream.Results = listResults(ctx, req, &r.ScheduleResource, listed)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,6 +21,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ScheduleResource{}
 var _ resource.ResourceWithImportState = &ScheduleResource{}
+var _ resource.ResourceWithIdentity = &ScheduleResource{}
 
 func NewScheduleResource() resource.Resource {
 	return &ScheduleResource{}
@@ -40,6 +42,12 @@ func (r *ScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+func (r *ScheduleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.IdIdentityAttributes,
+	}
+}
+
 func (r *ScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring ScheduleResource")
 
@@ -122,6 +130,7 @@ func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateReques
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the ScheduleResource into Terraform state")
 }
 
@@ -169,6 +178,7 @@ func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, r
 	tflog.Trace(ctx, "Read the ScheduleResource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the ScheduleResource into Terraform state")
 }
 
@@ -227,6 +237,7 @@ func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateReques
 	tflog.Trace(ctx, "Updated the ScheduleResource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the ScheduleResource into Terraform state")
 }
 
@@ -270,6 +281,37 @@ func (r *ScheduleResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *ScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
+	if team, name, found := splitNameImportId(req.ID); found {
+		// Imported by name, the team being given by ID or by name, or left empty to look the schedule up in all teams
+		var teamId, id string
+		var err error
+		if team != "" {
+			teamId, err = resolveTeamId(r.clientConfiguration, team)
+		}
+		if err == nil {
+			id, err = lookupIdByName(r.clientConfiguration, "schedule", "/v1/schedules", nil, name, func(item dto.Schedule) (string, string) {
+				if teamId != "" && item.TeamId != teamId {
+					return "", item.Id
+				}
+				return item.Name, item.Id
+			})
+		}
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Unable to import schedule, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import schedule, got error: %s", err))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccScheduleResource_Full(t *testing.T) {
@@ -70,6 +71,18 @@ resource "atlassian-operations_schedule" "example" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "atlassian-operations_schedule.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_schedule.example"].Primary.Attributes["team_id"] +
+							"/" +
+							state.RootModule().Resources["atlassian-operations_schedule.example"].Primary.Attributes["name"],
+						nil
+				},
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
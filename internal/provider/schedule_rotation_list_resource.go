@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &ScheduleRotationListResource{}
+var _ list.ListResourceWithConfigure = &ScheduleRotationListResource{}
+
+func NewScheduleRotationListResource() list.ListResource {
+	return &ScheduleRotationListResource{}
+}
+
+// ScheduleRotationListResource lists the rotations of a schedule.
+// The metadata, configuration and read logic are shared with ScheduleRotationResource.
+type ScheduleRotationListResource struct {
+	ScheduleRotationResource
+}
+
+func (r *ScheduleRotationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.ScheduleRotationListResourceAttributes,
+	}
+}
+
+func (r *ScheduleRotationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data dataModels.ScheduleRotationListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Trace(ctx, "Listing rotations")
+
+	rotations, err := fetchAllPages[dto.Rotation](r.clientConfiguration, fmt.Sprintf("/v1/schedules/%s/rotations", data.ScheduleId.ValueString()), nil)
+	if err != nil {
+		stream.Results = listError(ctx, "Unable to list rotations", err)
+		return
+	}
+
+	listed := make([]listedResource, 0, len(rotations))
+	for _, item := range rotations {
+		listed = append(listed, listedResource{
+			displayName: item.Name,
+			identity: map[string]types.String{
+				"id":          types.StringValue(item.Id),
+				"schedule_id": data.ScheduleId,
+			},
+		})
+	}
+
+	stream.Results = listResults(ctx, req, &r.ScheduleRotationResource, listed)
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccScheduleRotationListResource(t *testing.T) {
+	rotationName := uuid.NewString()
+	scheduleName := uuid.NewString()
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	scheduleId := &testAccStateVariable{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		// List resources are only supported from Terraform 1.14 onwards
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the rotations to list
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_schedule" "example" {
+  name    = "` + scheduleName + `"
+  team_id = atlassian-operations_team.example.id
+}
+
+resource "atlassian-operations_schedule_rotation" "first" {
+  schedule_id = atlassian-operations_schedule.example.id
+  name        = "` + rotationName + `-1"
+  start_date  = "2023-11-10T05:00:00Z"
+  type        = "weekly"
+}
+
+resource "atlassian-operations_schedule_rotation" "second" {
+  schedule_id = atlassian-operations_schedule.example.id
+  name        = "` + rotationName + `-2"
+  start_date  = "2023-11-10T05:00:00Z"
+  type        = "daily"
+}
+`,
+				Check: scheduleId.capture("atlassian-operations_schedule.example", "id"),
+			},
+			// List the rotations of the schedule along with their resource
+			{
+				Query: true,
+				Config: `
+variable "schedule_id" {
+  type = string
+}
+
+list "atlassian-operations_schedule_rotation" "test" {
+  provider         = atlassian-operations
+  include_resource = true
+
+  config {
+    schedule_id = var.schedule_id
+  }
+}
+`,
+				ConfigVariables: config.Variables{
+					"schedule_id": scheduleId,
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("atlassian-operations_schedule_rotation.test", 2),
+					querycheck.ExpectResourceKnownValues("atlassian-operations_schedule_rotation.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact(rotationName+"-1")), []querycheck.KnownValueCheck{
+							{Path: tfjsonpath.New("name"), KnownValue: knownvalue.StringExact(rotationName + "-1")},
+							{Path: tfjsonpath.New("type"), KnownValue: knownvalue.StringExact("weekly")},
+							{Path: tfjsonpath.New("start_date"), KnownValue: knownvalue.StringExact("2023-11-10T05:00:00Z")},
+						}),
+					querycheck.ExpectResourceKnownValues("atlassian-operations_schedule_rotation.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact(rotationName+"-2")), []querycheck.KnownValueCheck{
+							{Path: tfjsonpath.New("type"), KnownValue: knownvalue.StringExact("daily")},
+						}),
+				},
+			},
+			// The number of results is limited
+			{
+				Query: true,
+				Config: `
+variable "schedule_id" {
+  type = string
+}
+
+list "atlassian-operations_schedule_rotation" "test" {
+  provider = atlassian-operations
+  limit    = 1
+
+  config {
+    schedule_id = var.schedule_id
+  }
+}
+`,
+				ConfigVariables: config.Variables{
+					"schedule_id": scheduleId,
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("atlassian-operations_schedule_rotation.test", 1),
+				},
+			},
+		},
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"slices"
@@ -24,6 +25,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ScheduleRotationResource{}
 var _ resource.ResourceWithImportState = &ScheduleRotationResource{}
+var _ resource.ResourceWithIdentity = &ScheduleRotationResource{}
 
 func NewScheduleRotationResource() resource.Resource {
 	return &ScheduleRotationResource{}
@@ -44,6 +46,12 @@ func (r *ScheduleRotationResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+func (r *ScheduleRotationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.ScheduleRotationIdentityAttributes,
+	}
+}
+
 func (r *ScheduleRotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring ScheduleRotationResource")
 
@@ -148,6 +156,7 @@ func (r *ScheduleRotationResource) Create(ctx context.Context, req resource.Crea
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the ScheduleRotationResource into Terraform state")
 }
 
@@ -211,6 +220,7 @@ func (r *ScheduleRotationResource) Read(ctx context.Context, req resource.ReadRe
 	tflog.Trace(ctx, "Read the ScheduleRotationResource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the ScheduleRotationResource into Terraform state")
 }
 
@@ -298,6 +308,7 @@ func (r *ScheduleRotationResource) Update(ctx context.Context, req resource.Upda
 	tflog.Trace(ctx, "Updated the ScheduleRotationResource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the ScheduleRotationResource into Terraform state")
 }
 
@@ -342,11 +353,37 @@ func (r *ScheduleRotationResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *ScheduleRotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
+	if schedule, name, found := splitNameImportId(req.ID); found {
+		// Imported by name, the schedule being given by ID or by name
+		scheduleId, err := resolveScheduleId(r.clientConfiguration, schedule)
+		var id string
+		if err == nil {
+			id, err = lookupIdByName(r.clientConfiguration, "rotation", fmt.Sprintf("/v1/schedules/%s/rotations", scheduleId), nil, name, func(item dto.Rotation) (string, string) {
+				return item.Name, item.Id
+			})
+		}
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Unable to import schedule rotation, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import schedule rotation, got error: %s", err))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule_id"), scheduleId)...)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: id,schedule_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: id,schedule_id; or: schedule/name (schedule being the ID or the name of the schedule). Got: %q", req.ID),
 		)
 		return
 	}
@@ -107,6 +107,18 @@ resource "atlassian-operations_schedule_rotation" "example" {
 						nil
 				},
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "atlassian-operations_schedule_rotation.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_schedule_rotation.example"].Primary.Attributes["schedule_id"] +
+							"/" +
+							state.RootModule().Resources["atlassian-operations_schedule_rotation.example"].Primary.Attributes["name"],
+						nil
+				},
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
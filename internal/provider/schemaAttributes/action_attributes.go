@@ -0,0 +1,72 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var HeartbeatPingActionAttributes = map[string]schema.Attribute{
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns the heartbeat.",
+		Required:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the heartbeat to ping.",
+		Required:    true,
+	},
+}
+
+var MaintenanceCancelActionAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The ID of the maintenance window to cancel.",
+		Required:    true,
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns the maintenance window. Omit it for global maintenance windows.",
+		Optional:    true,
+	},
+}
+
+var SendTestAlertActionAttributes = map[string]schema.Attribute{
+	"api_key": schema.StringAttribute{
+		Description: "The API key of the API integration the alert is created through, such as the api_key attribute of an atlassian-operations_api_integration resource. The key cannot be read back from the API, so it has to be passed in. Pass it from a sensitive value, as action attributes cannot be marked sensitive themselves.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"message": schema.StringAttribute{
+		Description: "The message of the test alert. Limited to 130 characters.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 130),
+		},
+	},
+	"alias": schema.StringAttribute{
+		Description: "The alias of the test alert, used for de-duplication.",
+		Optional:    true,
+	},
+	"description": schema.StringAttribute{
+		Description: "The description of the test alert.",
+		Optional:    true,
+	},
+	"tags": schema.ListAttribute{
+		Description: "The tags of the test alert.",
+		ElementType: types.StringType,
+		Optional:    true,
+	},
+	"details": schema.MapAttribute{
+		Description: "The extra properties of the test alert.",
+		ElementType: types.StringType,
+		Optional:    true,
+	},
+	"priority": schema.StringAttribute{
+		Description: "The priority of the test alert. Valid values are 'P1' to 'P5'. Defaults to 'P3' on the API side.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("P1", "P2", "P3", "P4", "P5"),
+		},
+	},
+}
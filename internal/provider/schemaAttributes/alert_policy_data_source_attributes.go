@@ -0,0 +1,178 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var AlertPolicyDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the alert policy. Either id or name must be set to look up the alert policy.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		},
+	},
+	"type": schema.StringAttribute{
+		Computed:    true,
+		Description: "The type of the alert policy. Always 'alert'.",
+	},
+	"name": schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The name of the alert policy. Must match exactly one alert policy when used for the lookup.",
+	},
+	"description": schema.StringAttribute{
+		Computed:    true,
+		Description: "The description of the alert policy",
+	},
+	"team_id": schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the team this alert policy belongs to. Global alert policies are looked up when omitted.",
+	},
+	"enabled": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether the alert policy is enabled",
+	},
+	"order": schema.Int64Attribute{
+		Computed:    true,
+		Description: "The order of the alert policy",
+	},
+	"filter": schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "The filter configuration for the alert policy",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the filter",
+			},
+			"conditions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of filter conditions",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: CriteriaConditionDataSourceAttributes,
+				},
+			},
+		},
+	},
+	"time_restriction": schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "Time restriction configuration for the alert policy",
+		Attributes:  PolicyTimeRestrictionDataSourceAttributes,
+	},
+	"alias": schema.StringAttribute{
+		Computed:    true,
+		Description: "Alert alias template",
+	},
+	"message": schema.StringAttribute{
+		Computed:    true,
+		Description: "Alert message template",
+	},
+	"alert_description": schema.StringAttribute{
+		Computed:    true,
+		Description: "Alert description template",
+	},
+	"source": schema.StringAttribute{
+		Computed:    true,
+		Description: "Alert source template",
+	},
+	"entity": schema.StringAttribute{
+		Computed:    true,
+		Description: "Alert entity template",
+	},
+	"responders": schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "List of responders for the alert",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Computed:    true,
+					Description: "The type of the responder",
+				},
+				"id": schema.StringAttribute{
+					Computed:    true,
+					Description: "The ID of the responder",
+				},
+			},
+		},
+	},
+	"actions": schema.ListAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "List of actions for the alert",
+	},
+	"tags": schema.ListAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "List of tags for the alert",
+	},
+	"details": schema.MapAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "Additional details for the alert",
+	},
+	"continue": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether to continue processing after this policy",
+	},
+	"update_priority": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether to update the priority of the alert",
+	},
+	"priority_value": schema.StringAttribute{
+		Computed:    true,
+		Description: "If update priorty is enabled, this is the value to set the priority to",
+	},
+	"keep_original_responders": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether to keep the original responders",
+	},
+	"keep_original_details": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether to keep the original details",
+	},
+	"keep_original_actions": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether to keep the original actions",
+	},
+	"keep_original_tags": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether to keep the original tags",
+	},
+}
+
+var PolicyTimeRestrictionDataSourceAttributes = map[string]schema.Attribute{
+	"enabled": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether time restrictions are enabled",
+	},
+	"time_restrictions": schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "List of time restriction periods",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"start_hour": schema.Int64Attribute{
+					Computed:    true,
+					Description: "Start hour of the restriction period",
+				},
+				"start_minute": schema.Int64Attribute{
+					Computed:    true,
+					Description: "Start minute of the restriction period",
+				},
+				"end_hour": schema.Int64Attribute{
+					Computed:    true,
+					Description: "End hour of the restriction period",
+				},
+				"end_minute": schema.Int64Attribute{
+					Computed:    true,
+					Description: "End minute of the restriction period",
+				},
+			},
+		},
+	},
+}
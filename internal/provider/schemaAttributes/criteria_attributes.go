@@ -0,0 +1,240 @@
+package schemaAttributes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CriteriaOperations lists the operations accepted by criteria conditions.
+var CriteriaOperations = []string{
+	"matches", "contains", "starts-with", "ends-with", "equals", "contains-key", "contains-value",
+	"greater-than", "less-than", "is-empty", "equals-ignore-whitespace",
+}
+
+// CriteriaFields lists the fields criteria conditions are evaluated on.
+var CriteriaFields = []string{
+	"message", "alias", "description", "source", "entity", "tags", "actions", "extra-properties", "priority", "details", "responders",
+}
+
+// criteriaTextOperations are the operations accepted by the text fields.
+var criteriaTextOperations = []string{"matches", "contains", "starts-with", "ends-with", "equals", "equals-ignore-whitespace", "is-empty"}
+
+// criteriaFieldOperations lists the operations accepted by each field of CriteriaFields.
+var criteriaFieldOperations = map[string][]string{
+	"message":          criteriaTextOperations,
+	"alias":            criteriaTextOperations,
+	"description":      criteriaTextOperations,
+	"source":           criteriaTextOperations,
+	"entity":           criteriaTextOperations,
+	"tags":             {"contains", "is-empty"},
+	"actions":          {"contains", "is-empty"},
+	"responders":       {"contains", "is-empty"},
+	"extra-properties": append(slices.Clone(criteriaTextOperations), "contains-key", "contains-value"),
+	"details":          append(slices.Clone(criteriaTextOperations), "contains-key", "contains-value"),
+	"priority":         {"equals", "greater-than", "less-than"},
+}
+
+// criteriaKeyFields are the fields whose conditions can target a single key with the key attribute.
+var criteriaKeyFields = []string{"extra-properties", "details"}
+
+// CriteriaFieldOperations returns the operations accepted by a field, or false if the field is not known.
+func CriteriaFieldOperations(field string) ([]string, bool) {
+	operations, ok := criteriaFieldOperations[field]
+	return operations, ok
+}
+
+// CriteriaConditionsAttribute completes the conditions attribute of a criteria or filter with the attributes of the
+// conditions, shared by all the resources. fields lists the fields accepted by the API of the resource, whose
+// conditions are then validated against the operations accepted by their field; a nil fields accepts any field. The
+// order of the conditions which don't set it is their index in the list. extraAttributes are added to the attributes
+// of the conditions.
+func CriteriaConditionsAttribute(attribute schema.ListNestedAttribute, fields []string, extraAttributes map[string]schema.Attribute) schema.ListNestedAttribute {
+	field := schema.StringAttribute{
+		Description: "The field to evaluate.",
+		Required:    true,
+	}
+	operation := schema.StringAttribute{
+		Description: fmt.Sprintf("The comparison operation to perform. Valid values are: %s.", strings.Join(CriteriaOperations, ", ")),
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(CriteriaOperations...),
+		},
+	}
+	if fields != nil {
+		field.Description = fmt.Sprintf("The field to evaluate. Valid values are: %s.", strings.Join(fields, ", "))
+		field.Validators = []validator.String{
+			stringvalidator.OneOf(fields...),
+		}
+		operation.Description = "The comparison operation to perform. The operations accepted depend on the field: " + criteriaOperationsDescription(fields) + "."
+	}
+
+	attributes := map[string]schema.Attribute{
+		"field":     field,
+		"operation": operation,
+		"expected_value": schema.StringAttribute{
+			Description: "The value to compare against the field value. Required for all operations but 'is-empty', which must not set it. For 'contains-key', the name of the key.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"key": schema.StringAttribute{
+			Description: "The key of the value to evaluate, only for the extra-properties and details fields.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"not": schema.BoolAttribute{
+			Description: "Whether to negate the condition.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"order": schema.Int64Attribute{
+			Description: "The order of the condition in the conditions list. Defaults to the index of the condition in the list.",
+			Optional:    true,
+			Computed:    true,
+		},
+	}
+	for name, extraAttribute := range extraAttributes {
+		attributes[name] = extraAttribute
+	}
+
+	attribute.NestedObject = schema.NestedAttributeObject{
+		Attributes: attributes,
+		Validators: []validator.Object{
+			criteriaConditionValidator{fields: fields},
+		},
+	}
+	attribute.PlanModifiers = append(attribute.PlanModifiers, criteriaConditionOrderModifier{})
+	return attribute
+}
+
+func criteriaOperationsDescription(fields []string) string {
+	groups := make([]string, 0, len(fields))
+	for _, field := range fields {
+		groups = append(groups, fmt.Sprintf("%s (%s)", field, strings.Join(criteriaFieldOperations[field], ", ")))
+	}
+	return strings.Join(groups, "; ")
+}
+
+var _ validator.Object = criteriaConditionValidator{}
+
+// criteriaConditionValidator checks that expected_value is set for all operations but is-empty and, for the fields it
+// knows, that the operation of a condition is accepted by its field and that key is only set for the fields holding
+// key-value pairs.
+type criteriaConditionValidator struct {
+	fields []string
+}
+
+func (v criteriaConditionValidator) Description(_ context.Context) string {
+	if v.fields == nil {
+		return "The expected_value must be set unless the operation is 'is-empty'"
+	}
+	return "The operation must be accepted by the field of the condition, and expected_value must be set unless the operation is 'is-empty'"
+}
+
+func (v criteriaConditionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v criteriaConditionValidator) ValidateObject(_ context.Context, request validator.ObjectRequest, response *validator.ObjectResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := request.ConfigValue.Attributes()
+	field, fieldKnown := knownString(attributes["field"])
+	operation, operationKnown := knownString(attributes["operation"])
+	if !fieldKnown || !operationKnown {
+		return
+	}
+
+	operations, ok := criteriaFieldOperations[field]
+	ok = ok && slices.Contains(v.fields, field)
+	if ok && slices.Contains(CriteriaOperations, operation) && !slices.Contains(operations, operation) {
+		response.Diagnostics.AddAttributeError(request.Path.AtName("operation"), "Invalid Attribute Combination",
+			fmt.Sprintf("The operation '%s' can't be used with the field '%s', valid operations are: %s", operation, field, strings.Join(operations, ", ")))
+	}
+
+	if expectedValue := attributes["expected_value"]; !expectedValue.IsUnknown() {
+		value, _ := knownString(expectedValue)
+		if operation == "is-empty" && value != "" {
+			response.Diagnostics.AddAttributeError(request.Path.AtName("expected_value"), "Invalid Attribute Combination",
+				"The expected_value must not be set for the 'is-empty' operation")
+		} else if operation != "is-empty" && expectedValue.IsNull() {
+			response.Diagnostics.AddAttributeError(request.Path.AtName("expected_value"), "Missing Attribute Configuration",
+				fmt.Sprintf("The expected_value must be set for the '%s' operation", operation))
+		}
+	}
+
+	if key, _ := knownString(attributes["key"]); key != "" && ok && !slices.Contains(criteriaKeyFields, field) {
+		response.Diagnostics.AddAttributeError(request.Path.AtName("key"), "Invalid Attribute Combination",
+			fmt.Sprintf("The key can only be set for the fields %s, got: %s", strings.Join(criteriaKeyFields, ", "), field))
+	}
+}
+
+func knownString(value attr.Value) (string, bool) {
+	s, ok := value.(types.String)
+	if !ok || s.IsNull() || s.IsUnknown() {
+		return "", false
+	}
+	return s.ValueString(), true
+}
+
+var _ planmodifier.List = criteriaConditionOrderModifier{}
+
+// criteriaConditionOrderModifier sets the order of the conditions which don't configure it to their index in the
+// list, so that it is known at plan time and sent to the API.
+type criteriaConditionOrderModifier struct{}
+
+func (m criteriaConditionOrderModifier) Description(_ context.Context) string {
+	return "The order of the conditions defaults to their index in the list"
+}
+
+func (m criteriaConditionOrderModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m criteriaConditionOrderModifier) PlanModifyList(ctx context.Context, request planmodifier.ListRequest, response *planmodifier.ListResponse) {
+	if request.PlanValue.IsNull() || request.PlanValue.IsUnknown() {
+		return
+	}
+
+	elements := request.PlanValue.Elements()
+	modified := make([]attr.Value, len(elements))
+	for i, element := range elements {
+		modified[i] = element
+
+		condition, ok := element.(types.Object)
+		if !ok || condition.IsNull() || condition.IsUnknown() || !condition.Attributes()["order"].IsUnknown() {
+			continue
+		}
+
+		attributes := make(map[string]attr.Value, len(condition.Attributes()))
+		for name, value := range condition.Attributes() {
+			attributes[name] = value
+		}
+		attributes["order"] = types.Int64Value(int64(i))
+
+		value, diags := types.ObjectValue(condition.AttributeTypes(ctx), attributes)
+		response.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		modified[i] = value
+	}
+
+	planValue, diags := types.ListValue(request.PlanValue.ElementType(ctx), modified)
+	response.Diagnostics.Append(diags...)
+	if !diags.HasError() {
+		response.PlanValue = planValue
+	}
+}
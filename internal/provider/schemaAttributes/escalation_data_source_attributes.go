@@ -0,0 +1,98 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var EscalationDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the escalation policy. Either id or name must be set to look up the escalation policy.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns this escalation policy.",
+		Required:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the escalation policy. Must match exactly one escalation policy of the team when used for the lookup.",
+		Optional:    true,
+		Computed:    true,
+	},
+	"description": schema.StringAttribute{
+		Description: "A detailed description of the escalation policy's purpose and behavior.",
+		Computed:    true,
+	},
+	"rules": schema.SetNestedAttribute{
+		Description: "List of escalation rules that define how and when to escalate alerts.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: EscalationRulesResponseDataSourceAttributes,
+		},
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the escalation policy is active.",
+		Computed:    true,
+	},
+	"repeat": schema.SingleNestedAttribute{
+		Description: "Configuration for repeating escalations, including intervals, counts, and state management.",
+		Computed:    true,
+		Attributes:  EscalationRepeatDataSourceAttributes,
+	},
+}
+
+var EscalationRepeatDataSourceAttributes = map[string]schema.Attribute{
+	"wait_interval": schema.Int32Attribute{
+		Description: "The time to wait (in minutes) before repeating the escalation rules.",
+		Computed:    true,
+	},
+	"count": schema.Int32Attribute{
+		Description: "The number of times to repeat the escalation rules.",
+		Computed:    true,
+	},
+	"reset_recipient_states": schema.BoolAttribute{
+		Description: "Whether to reset acknowledgment and seen states for recipients on each repeat cycle if the alert remains open.",
+		Computed:    true,
+	},
+	"close_alert_after_all": schema.BoolAttribute{
+		Description: "Whether to automatically close the alert after all repeat cycles are completed.",
+		Computed:    true,
+	},
+}
+
+var EscalationRulesResponseDataSourceAttributes = map[string]schema.Attribute{
+	"condition": schema.StringAttribute{
+		Description: "The condition that triggers this escalation rule ('if-not-acked' or 'if-not-closed').",
+		Computed:    true,
+	},
+	"notify_type": schema.StringAttribute{
+		Description: "How recipients are selected for notification ('default', 'next', 'previous', 'users', 'admins', 'random' or 'all').",
+		Computed:    true,
+	},
+	"delay": schema.Int64Attribute{
+		Description: "The time to wait (in minutes) before executing this escalation rule.",
+		Computed:    true,
+	},
+	"recipient": schema.SingleNestedAttribute{
+		Description: "The target recipient for this escalation rule.",
+		Computed:    true,
+		Attributes:  EscalationRuleRecipientDataSourceAttributes,
+	},
+}
+
+var EscalationRuleRecipientDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the recipient (user ID, schedule ID, or team ID).",
+		Computed:    true,
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of recipient ('user', 'schedule' or 'team').",
+		Computed:    true,
+	},
+}
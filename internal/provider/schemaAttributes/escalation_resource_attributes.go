@@ -1,9 +1,15 @@
 package schemaAttributes
 
 import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
@@ -11,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var EscalationResourceAttributes = map[string]schema.Attribute{
@@ -43,6 +50,9 @@ var EscalationResourceAttributes = map[string]schema.Attribute{
 		Required:    true,
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: EscalationRulesResponseResourceAttributes,
+			Validators: []validator.Object{
+				escalationRuleNotifyTypeValidator{},
+			},
 		},
 	},
 	"enabled": schema.BoolAttribute{
@@ -101,7 +111,7 @@ var EscalationRulesResponseResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 	"notify_type": schema.StringAttribute{
-		Description: "How to select recipients for notification. Valid values are: 'default' (use default notification rules), 'next' (next in rotation), 'previous' (previous in rotation), 'users' (specific users), 'admins' (team admins), 'random' (random member), or 'all' (all members).",
+		Description: "How to select recipients for notification. Valid values are: 'default' (use default notification rules), 'next' (next in rotation), 'previous' (previous in rotation), 'users' (specific users), 'admins' (team admins), 'random' (random member), or 'all' (all members). 'next' and 'previous' can only be used with schedule recipients, 'users', 'admins', 'random' and 'all' only with team recipients.",
 		Required:    true,
 		Validators: []validator.String{
 			stringvalidator.OneOf("default", "next", "previous", "users", "admins", "random", "all"),
@@ -118,14 +128,35 @@ var EscalationRulesResponseResourceAttributes = map[string]schema.Attribute{
 		Description: "The target recipient for this escalation rule. Can be a user, schedule, or team.",
 		Required:    true,
 		Attributes:  EscalationRuleRecipientResourceAttributes,
+		Validators: []validator.Object{
+			escalationRecipientValidator{},
+		},
 	},
 }
 
 var EscalationRuleRecipientResourceAttributes = map[string]schema.Attribute{
 	"id": schema.StringAttribute{
-		Description: "The unique identifier of the recipient (user ID, schedule ID, or team ID).",
+		Description: "The unique identifier of the recipient (user ID, schedule ID, or team ID). Resolved from email or name when one of them is set instead.",
 		Optional:    true,
 		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("name")),
+		},
+	},
+	"email": schema.StringAttribute{
+		Description: "The email address of the user recipient, resolved to the ID of the user. Only for the 'user' type. Users are looked up through the organization_id of the provider configuration, unless the product type is 'jira-service-desk'.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the schedule or team recipient, resolved to its ID. Only for the 'schedule' and 'team' types. Teams are looked up through the organization_id of the provider configuration.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email")),
+		},
 	},
 	"type": schema.StringAttribute{
 		Description: "The type of recipient. Valid values are 'user' (individual user), 'schedule' (on-call schedule), or 'team' (entire team).",
@@ -135,3 +166,96 @@ var EscalationRuleRecipientResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 }
+
+// escalationRecipientTypesByNotifyType lists the recipient types each notify_type can be used with. The notify types
+// which are not listed can be used with all recipient types.
+var escalationRecipientTypesByNotifyType = map[string][]string{
+	"next":     {"schedule"},
+	"previous": {"schedule"},
+	"users":    {"team"},
+	"admins":   {"team"},
+	"random":   {"team"},
+	"all":      {"team"},
+}
+
+// escalationRecipientTypesByReference lists the recipient types each way of referencing the recipient can be used with.
+var escalationRecipientTypesByReference = map[string][]string{
+	"email": {"user"},
+	"name":  {"schedule", "team"},
+}
+
+var _ validator.Object = escalationRuleNotifyTypeValidator{}
+
+// escalationRuleNotifyTypeValidator checks that the notify_type of an escalation rule can be used with the type of its
+// recipient: next and previous only select the on-call users of schedules, users, admins, random and all only the
+// members of teams.
+type escalationRuleNotifyTypeValidator struct{}
+
+func (v escalationRuleNotifyTypeValidator) Description(_ context.Context) string {
+	return "The notify_type must be 'default' or 'next'/'previous' for schedules, 'users'/'admins'/'random'/'all' for teams"
+}
+
+func (v escalationRuleNotifyTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v escalationRuleNotifyTypeValidator) ValidateObject(_ context.Context, request validator.ObjectRequest, response *validator.ObjectResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := request.ConfigValue.Attributes()
+	notifyType, notifyTypeKnown := knownString(attributes["notify_type"])
+	recipient, ok := attributes["recipient"].(types.Object)
+	if !notifyTypeKnown || !ok || recipient.IsNull() || recipient.IsUnknown() {
+		return
+	}
+	recipientType, recipientTypeKnown := knownString(recipient.Attributes()["type"])
+	if !recipientTypeKnown {
+		return
+	}
+
+	if recipientTypes, ok := escalationRecipientTypesByNotifyType[notifyType]; ok && !slices.Contains(recipientTypes, recipientType) {
+		response.Diagnostics.AddAttributeError(request.Path.AtName("notify_type"), "Invalid Attribute Combination",
+			fmt.Sprintf("The notify_type '%s' can only be used with recipients of type %s, got: %s", notifyType, strings.Join(recipientTypes, ", "), recipientType))
+	}
+}
+
+var _ validator.Object = escalationRecipientValidator{}
+
+// escalationRecipientValidator checks that a recipient is referenced by id, or by the email or name accepted by its
+// type.
+type escalationRecipientValidator struct{}
+
+func (v escalationRecipientValidator) Description(_ context.Context) string {
+	return "The recipient must set id, email for users, or name for schedules and teams"
+}
+
+func (v escalationRecipientValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v escalationRecipientValidator) ValidateObject(_ context.Context, request validator.ObjectRequest, response *validator.ObjectResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := request.ConfigValue.Attributes()
+	if attributes["id"].IsNull() && attributes["email"].IsNull() && attributes["name"].IsNull() {
+		response.Diagnostics.AddAttributeError(request.Path, "Missing Attribute Configuration",
+			"The recipient must set one of id, email or name")
+		return
+	}
+
+	recipientType, ok := knownString(attributes["type"])
+	if !ok {
+		return
+	}
+
+	for _, reference := range []string{"email", "name"} {
+		if recipientTypes := escalationRecipientTypesByReference[reference]; !attributes[reference].IsNull() && !slices.Contains(recipientTypes, recipientType) {
+			response.Diagnostics.AddAttributeError(request.Path.AtName(reference), "Invalid Attribute Combination",
+				fmt.Sprintf("The %s can only be set for recipients of type %s, got: %s", reference, strings.Join(recipientTypes, ", "), recipientType))
+		}
+	}
+}
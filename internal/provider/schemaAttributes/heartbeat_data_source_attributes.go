@@ -0,0 +1,101 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var HeartbeatDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The ID of the heartbeat.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the heartbeat.",
+		Required:    true,
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns the heartbeat.",
+		Required:    true,
+	},
+	"description": schema.StringAttribute{
+		Description: "Description of the heartbeat.",
+		Computed:    true,
+	},
+	"interval": schema.Int64Attribute{
+		Description: "The interval value for the heartbeat check.",
+		Computed:    true,
+	},
+	"interval_unit": schema.StringAttribute{
+		Description: "The unit for the interval ('minutes', 'hours' or 'days').",
+		Computed:    true,
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the heartbeat is enabled or not.",
+		Computed:    true,
+	},
+	"status": schema.StringAttribute{
+		Description: "The current status of the heartbeat.",
+		Computed:    true,
+	},
+	"expired": schema.BoolAttribute{
+		Description: "Whether the heartbeat has expired, i.e. no ping was received within its interval.",
+		Computed:    true,
+	},
+	"last_ping_time": schema.StringAttribute{
+		Description: "The time of the last ping received by the heartbeat.",
+		Computed:    true,
+	},
+	"alert_message": schema.StringAttribute{
+		Description: "The message of the alert created when the heartbeat expires.",
+		Computed:    true,
+	},
+	"alert_tags": schema.SetAttribute{
+		Description: "Tags of the alert created when the heartbeat expires.",
+		Computed:    true,
+		ElementType: types.StringType,
+	},
+	"alert_priority": schema.StringAttribute{
+		Description: "The priority of the alert created when the heartbeat expires.",
+		Computed:    true,
+	},
+}
+
+var HeartbeatsDataSourceAttributes = map[string]schema.Attribute{
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team whose heartbeats are listed.",
+		Required:    true,
+	},
+	"heartbeats": schema.ListNestedAttribute{
+		Description: "The heartbeats of the team.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: HeartbeatsDataSourceNestedAttributes,
+		},
+	},
+}
+
+var HeartbeatsDataSourceNestedAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The ID of the heartbeat.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the heartbeat.",
+		Computed:    true,
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns the heartbeat.",
+		Computed:    true,
+	},
+	"description":    HeartbeatDataSourceAttributes["description"],
+	"interval":       HeartbeatDataSourceAttributes["interval"],
+	"interval_unit":  HeartbeatDataSourceAttributes["interval_unit"],
+	"enabled":        HeartbeatDataSourceAttributes["enabled"],
+	"status":         HeartbeatDataSourceAttributes["status"],
+	"expired":        HeartbeatDataSourceAttributes["expired"],
+	"last_ping_time": HeartbeatDataSourceAttributes["last_ping_time"],
+	"alert_message":  HeartbeatDataSourceAttributes["alert_message"],
+	"alert_tags":     HeartbeatDataSourceAttributes["alert_tags"],
+	"alert_priority": HeartbeatDataSourceAttributes["alert_priority"],
+}
@@ -1,31 +1,54 @@
 package schemaAttributes
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var HeartbeatResourceAttributes = map[string]schema.Attribute{
-	"name": schema.StringAttribute{
-		Description: "The name of the heartbeat. This is a unique identifier and cannot be changed after creation.",
-		Required:    true,
+	"id": schema.StringAttribute{
+		Description: "The ID of the heartbeat, which is kept when the heartbeat is renamed.",
+		Computed:    true,
 		PlanModifiers: []planmodifier.String{
-			stringplanmodifier.RequiresReplace(),
+			stringplanmodifier.UseStateForUnknown(),
 		},
 	},
+	"name": schema.StringAttribute{
+		Description: "The name of the heartbeat, unique within its team. Renaming a heartbeat updates it in place, keeping its ping history.",
+		Required:    true,
+	},
 	"description": schema.StringAttribute{
 		Description: "Description of the heartbeat.",
 		Optional:    true,
 	},
 	"interval": schema.Int64Attribute{
-		Description: "The interval value for the heartbeat check.",
-		Required:    true,
+		Description: "The interval value for the heartbeat check. Either interval and interval_unit, or period must be set.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+			int64validator.ExactlyOneOf(path.MatchRoot("interval"), path.MatchRoot("period")),
+			int64validator.AlsoRequires(path.MatchRoot("interval_unit")),
+		},
 	},
 	"interval_unit": schema.StringAttribute{
-		Description: "The unit for the interval (e.g., 'minutes', 'hours', 'days').",
-		Required:    true,
+		Description: "The unit for the interval ('minutes', 'hours' or 'days').",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("minutes", "hours", "days"),
+			stringvalidator.AlsoRequires(path.MatchRoot("interval")),
+		},
+	},
+	"period": schema.StringAttribute{
+		Description: "The interval of the heartbeat check as a duration, e.g. '15m', '2h' or '48h', which sets interval and interval_unit to the largest unit dividing it. Either interval and interval_unit, or period must be set.",
+		Optional:    true,
 	},
 	"enabled": schema.BoolAttribute{
 		Description: "Whether the heartbeat is enabled or not.",
@@ -36,8 +59,11 @@ var HeartbeatResourceAttributes = map[string]schema.Attribute{
 		Computed:    true,
 	},
 	"team_id": schema.StringAttribute{
-		Description: "The ID of the team that owns the heartbeat.",
+		Description: "The ID of the team that owns the heartbeat. Changing the team recreates the heartbeat.",
 		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
 	},
 	"alert_message": schema.StringAttribute{
 		Description: "The message to be displayed when an alert is triggered due to missed heartbeat.",
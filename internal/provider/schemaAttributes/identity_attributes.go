@@ -0,0 +1,120 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var IdIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the resource.",
+		RequiredForImport: true,
+	},
+}
+
+var TeamIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the team.",
+		RequiredForImport: true,
+	},
+	"organization_id": identityschema.StringAttribute{
+		Description:       "The ID of the organization the team belongs to.",
+		RequiredForImport: true,
+	},
+}
+
+var ScheduleRotationIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the rotation.",
+		RequiredForImport: true,
+	},
+	"schedule_id": identityschema.StringAttribute{
+		Description:       "The ID of the schedule the rotation belongs to.",
+		RequiredForImport: true,
+	},
+}
+
+// TeamScopedIdentityAttributes identifies the resources that always belong to a team.
+var TeamScopedIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the resource.",
+		RequiredForImport: true,
+	},
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team that owns the resource.",
+		RequiredForImport: true,
+	},
+}
+
+// OptionallyTeamScopedIdentityAttributes identifies the resources that are either global or owned by a team.
+var OptionallyTeamScopedIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the resource.",
+		RequiredForImport: true,
+	},
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team that owns the resource. Not set for global resources.",
+		OptionalForImport: true,
+	},
+}
+
+var IntegrationActionIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the integration action.",
+		RequiredForImport: true,
+	},
+	"integration_id": identityschema.StringAttribute{
+		Description:       "The ID of the integration the action belongs to.",
+		RequiredForImport: true,
+	},
+}
+
+var TeamMemberIdentityAttributes = map[string]identityschema.Attribute{
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team.",
+		RequiredForImport: true,
+	},
+	"account_id": identityschema.StringAttribute{
+		Description:       "The account ID of the member.",
+		RequiredForImport: true,
+	},
+	"organization_id": identityschema.StringAttribute{
+		Description:       "The ID of the organization the team belongs to.",
+		RequiredForImport: true,
+	},
+}
+
+var TeamRoutingRuleOrderIdentityAttributes = map[string]identityschema.Attribute{
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team whose routing rules are ordered.",
+		RequiredForImport: true,
+	},
+}
+
+var PolicyOrderIdentityAttributes = map[string]identityschema.Attribute{
+	"policy_type": identityschema.StringAttribute{
+		Description:       "The type of the ordered policies, either 'alert' or 'notification'.",
+		RequiredForImport: true,
+	},
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team whose policies are ordered. Not set for the global alert policies.",
+		OptionalForImport: true,
+	},
+}
+
+var UserNotificationProfileIdentityAttributes = map[string]identityschema.Attribute{
+	"name": identityschema.StringAttribute{
+		Description:       "The name of the notification profile.",
+		RequiredForImport: true,
+	},
+	"contacts": identityschema.ListAttribute{
+		Description:       "The contacts of the profile, each as contact_name=contact_id.",
+		ElementType:       types.StringType,
+		RequiredForImport: true,
+	},
+	"rule_ids": identityschema.ListAttribute{
+		Description:       "The IDs of the notification rules of the profile.",
+		ElementType:       types.StringType,
+		RequiredForImport: true,
+	},
+}
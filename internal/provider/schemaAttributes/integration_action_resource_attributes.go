@@ -3,6 +3,7 @@ package schemaAttributes
 import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes/customValidators"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -10,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
 )
 
 var IntegrationActionResourceAttributes = map[string]schema.Attribute{
@@ -81,52 +84,17 @@ var IntegrationActionResourceAttributes = map[string]schema.Attribute{
 					stringvalidator.OneOf("match-all", "match-any-condition", "match-all-conditions"),
 				},
 			},
-			"conditions": schema.ListNestedAttribute{
+			"conditions": CriteriaConditionsAttribute(schema.ListNestedAttribute{
 				Description: "List of conditions for the filter",
 				Optional:    true,
 				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"field": schema.StringAttribute{
-							Description: "The incident field to evaluate (e.g., 'message', 'priority', 'tags').",
-							Required:    true,
-						},
-						"operation": schema.StringAttribute{
-							Description: "The comparison operation to perform (e.g., 'matches', 'contains', 'starts-with', 'ends-with', 'equals', 'contains-key', 'contains-value', 'greater-than', 'less-than', 'is-empty', 'equals-ignore-whitespace').",
-							Required:    true,
-							Validators: []validator.String{
-								stringvalidator.OneOf("matches", "contains", "starts-with", "ends-with", "equals", "contains-key", "contains-value", "greater-than", "less-than", "is-empty", "equals-ignore-whitespace"),
-							},
-						},
-						"expected_value": schema.StringAttribute{
-							Description: "The value to compare against the field value.",
-							Optional:    true,
-							Computed:    true,
-						},
-						"key": schema.StringAttribute{
-							Description: "If field is set as extra-properties, key could be used for key-value pair.",
-							Optional:    true,
-							Computed:    true,
-						},
-						"not": schema.BoolAttribute{
-							Description: "Indicates behaviour of the given operation.",
-							Optional:    true,
-							Computed:    true,
-							Default:     booldefault.StaticBool(false),
-						},
-						"order": schema.Int64Attribute{
-							Description: "Order of the condition in conditions list.",
-							Optional:    true,
-							Computed:    true,
-						},
-						"system_condition": schema.BoolAttribute{
-							Description: "Whether the condition is a system condition",
-							Optional:    true,
-							Computed:    true,
-						},
-					},
+			}, nil, map[string]schema.Attribute{
+				"system_condition": schema.BoolAttribute{
+					Description: "Whether the condition is a system condition",
+					Optional:    true,
+					Computed:    true,
 				},
-			},
+			}),
 		},
 	},
 	"type_specific_properties": schema.StringAttribute{
@@ -135,6 +103,40 @@ var IntegrationActionResourceAttributes = map[string]schema.Attribute{
 		Optional:    true,
 		Computed:    true,
 	},
+	"webhook": schema.SingleNestedAttribute{
+		Description: "Typed type-specific properties for actions of webhook integrations. Cannot be used together with type_specific_properties.",
+		Optional:    true,
+		Validators: []validator.Object{
+			objectvalidator.ConflictsWith(path.MatchRoot("type_specific_properties")),
+		},
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Description: "The URL the action posts the alert payload to. Overrides the URL of the webhook integration.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https?://\S+$`), "must be an http or https URL"),
+				},
+			},
+			"headers": schema.MapAttribute{
+				Description: "Custom headers sent with the webhook request of this action. Values are treated as sensitive.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"add_alert_description": schema.BoolAttribute{
+				Description: "Whether the alert description is included in the webhook payload. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"add_alert_details": schema.BoolAttribute{
+				Description: "Whether the alert details (extra properties) are included in the webhook payload. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	},
 	"field_mappings": schema.StringAttribute{
 		CustomType:  jsontypes.ExactType{},
 		Description: "Field mappings for the integration action",
@@ -0,0 +1,91 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var IntegrationDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the integration.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the integration. Used together with type and team_id to look up the integration.",
+		Required:    true,
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of the integration (e.g., 'API', 'Email'). The comparison is case-insensitive.",
+		Required:    true,
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns the integration. When omitted, integrations of all teams and global integrations are searched.",
+		Optional:    true,
+		Computed:    true,
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the integration is enabled.",
+		Computed:    true,
+	},
+	"advanced": schema.BoolAttribute{
+		Description: "Indicates whether this is an advanced integration with additional configuration options.",
+		Computed:    true,
+	},
+	"include_api_key": schema.BoolAttribute{
+		Description: "Whether to expose the API key of the integration in the api_key attribute. Defaults to false.",
+		Optional:    true,
+	},
+	"api_key": schema.StringAttribute{
+		Description: "The API key of the integration. Only populated when include_api_key is set to true and the API returns the key.",
+		Computed:    true,
+		Sensitive:   true,
+	},
+	"maintenance_sources": schema.ListNestedAttribute{
+		Description: "List of maintenance windows associated with this integration.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: IntegrationMaintenanceSourceDataSourceAttributes,
+		},
+	},
+	"directions": schema.ListAttribute{
+		Description: "List of supported communication directions for this integration (e.g., 'incoming', 'outgoing').",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"domains": schema.ListAttribute{
+		Description: "List of domains associated with this integration.",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"type_specific_properties": schema.StringAttribute{
+		Description: "JSON object containing integration-specific configuration properties. The schema depends on the integration type.",
+		CustomType:  jsontypes.ExactType{},
+		Computed:    true,
+	},
+}
+
+var IntegrationMaintenanceSourceDataSourceAttributes = map[string]schema.Attribute{
+	"maintenance_id": schema.StringAttribute{
+		Description: "The unique identifier of the maintenance window.",
+		Computed:    true,
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the maintenance window is active.",
+		Computed:    true,
+	},
+	"interval": schema.SingleNestedAttribute{
+		Description: "The time interval during which the maintenance window is active.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"start_time_millis": schema.Int64Attribute{
+				Description: "The start time of the maintenance window in Unix milliseconds (UTC).",
+				Computed:    true,
+			},
+			"end_time_millis": schema.Int64Attribute{
+				Description: "The end time of the maintenance window in Unix milliseconds (UTC).",
+				Computed:    true,
+			},
+		},
+	},
+}
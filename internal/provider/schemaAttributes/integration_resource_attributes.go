@@ -0,0 +1,111 @@
+package schemaAttributes
+
+import (
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/integrationTypes"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var IntegrationResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the integration. This is automatically generated when the integration is created.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the integration. Must be between 1 and 250 characters.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 250),
+		},
+	},
+	"api_key": schema.StringAttribute{
+		Description: "The API key of the integration. Only available after the integration is created and cannot be fetched later.",
+		Computed:    true,
+		Sensitive:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of the integration. Changing the type forces a new integration to be created. Valid values are: " + joinQuoted(integrationTypes.Names()) + ".",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(integrationTypes.Names()...),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the integration is enabled. When disabled, the integration will not process any requests. Defaults to false.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns this integration. Global integrations are created when omitted. Cannot be changed after creation.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"advanced": schema.BoolAttribute{
+		Description: "Indicates whether this is an advanced integration with additional configuration options.",
+		Computed:    true,
+	},
+	"maintenance_sources": schema.ListNestedAttribute{
+		Description: "List of maintenance windows associated with this integration.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: ApiIntegrationResourceMaintenanceSourceAttributes,
+		},
+	},
+	"directions": schema.ListAttribute{
+		Description: "The directions the integration is used for. 'incoming' integrations receive alerts from the source, 'outgoing' integrations forward alert actions to it. Each direction must be supported by the integration type.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.List{
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(stringvalidator.OneOf(integrationTypes.DirectionIncoming, integrationTypes.DirectionOutgoing)),
+		},
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"domains": schema.ListAttribute{
+		Description: "List of domains associated with this integration.",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"type_specific_properties": schema.StringAttribute{
+		Description: "JSON object containing integration-specific configuration properties. The properties are validated against the known properties of the integration type.",
+		CustomType:  jsontypes.ExactType{},
+		Optional:    true,
+		Computed:    true,
+	},
+}
+
+func joinQuoted(values []string) string {
+	result := ""
+	for i, value := range values {
+		if i > 0 {
+			result += ", "
+		}
+		result += "'" + value + "'"
+	}
+	return result
+}
@@ -0,0 +1,45 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+var TeamListResourceAttributes = map[string]schema.Attribute{
+	"organization_id": schema.StringAttribute{
+		Description: "The ID of the organization whose teams are listed.",
+		Required:    true,
+	},
+}
+
+var ScheduleListResourceAttributes = map[string]schema.Attribute{}
+
+var ScheduleRotationListResourceAttributes = map[string]schema.Attribute{
+	"schedule_id": schema.StringAttribute{
+		Description: "The ID of the schedule whose rotations are listed.",
+		Required:    true,
+	},
+}
+
+// TeamScopedListResourceAttributes is the configuration of the resources that are always listed for a team.
+var TeamScopedListResourceAttributes = map[string]schema.Attribute{
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team whose resources are listed.",
+		Required:    true,
+	},
+}
+
+// OptionallyTeamScopedListResourceAttributes is the configuration of the resources that are either global or owned by a
+// team. The global resources are listed when no team is set.
+var OptionallyTeamScopedListResourceAttributes = map[string]schema.Attribute{
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team whose resources are listed. The global resources are listed when not set.",
+		Optional:    true,
+	},
+}
+
+var IntegrationListResourceAttributes = map[string]schema.Attribute{
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team whose integrations are listed. The integrations of every team are listed when not set.",
+		Optional:    true,
+	},
+}
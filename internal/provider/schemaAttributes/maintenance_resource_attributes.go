@@ -2,11 +2,20 @@ package schemaAttributes
 
 import (
 	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // MaintenanceResourceAttributes defines the schema for the Maintenance resource
@@ -23,16 +32,70 @@ var MaintenanceResourceAttributes = map[string]schema.Attribute{
 		MarkdownDescription: "The description of the maintenance window",
 	},
 	"start_date": schema.StringAttribute{
-		Required:            true,
-		MarkdownDescription: "The start date/time of the maintenance window in ISO8601 format (e.g., 2023-06-15T10:00:00Z)",
+		Optional:            true,
+		Computed:            true,
+		CustomType:          timetypes.RFC3339Type{},
+		MarkdownDescription: "The start date/time of the maintenance window in RFC3339 format (e.g., 2023-06-15T10:00:00Z). Either `start_date` and `end_date`, or `schedule` must be set. Computed from `schedule` when it is set",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("start_date"), path.MatchRoot("schedule")),
+			stringvalidator.AlsoRequires(path.MatchRoot("end_date")),
+		},
 	},
 	"end_date": schema.StringAttribute{
-		Required:            true,
-		MarkdownDescription: "The end date/time of the maintenance window in ISO8601 format (e.g., 2023-06-15T14:00:00Z)",
+		Optional:            true,
+		Computed:            true,
+		CustomType:          timetypes.RFC3339Type{},
+		MarkdownDescription: "The end date/time of the maintenance window in RFC3339 format (e.g., 2023-06-15T14:00:00Z). Computed from `schedule` when it is set",
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("start_date")),
+		},
+	},
+	"schedule": schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: "A weekly recurrence of the maintenance window. The next upcoming window is created, and rolled forward to the next occurrence on the first apply after it completes. The dates of a new window are computed when it is applied",
+		Attributes: map[string]schema.Attribute{
+			"days": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The days of the week the maintenance window starts on (e.g., sunday)",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday")),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The time of the day the maintenance window starts at, in HH:MM format (e.g., 02:00)",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "must be a time of the day in HH:MM format"),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("UTC"),
+				MarkdownDescription: "The IANA time zone of `start_time` (e.g., Europe/Berlin). Defaults to UTC",
+			},
+			"duration": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The duration of the maintenance window (e.g., 4h or 90m)",
+			},
+		},
+	},
+	"next_start": schema.StringAttribute{
+		Computed:            true,
+		CustomType:          timetypes.RFC3339Type{},
+		MarkdownDescription: "The start of the window following the current one when `schedule` is set, to which the maintenance is rolled forward once the current window completes",
 	},
 	"status": schema.StringAttribute{
 		Computed:            true,
-		MarkdownDescription: "The status of the maintenance window (e.g., scheduled, in_progress, completed, cancelled)",
+		MarkdownDescription: "The status of the maintenance window (e.g., planned, active, past, cancelled). Completed maintenance windows can't be updated, changing their configuration replaces them",
+	},
+	"cancel_on_destroy": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Whether destroying the resource cancels the maintenance window instead of deleting it, keeping it in the history. Active windows are cancelled, completed windows are left as they are and planned windows are deleted. Defaults to false",
 	},
 	"team_id": schema.StringAttribute{
 		Optional:            true,
@@ -48,9 +111,46 @@ var MaintenanceResourceAttributes = map[string]schema.Attribute{
 				"Force replacement since method value updated"),
 		},
 	},
+	"integration_ids": schema.SetAttribute{
+		Optional:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "The IDs of the integrations affected during the maintenance window, expanded into `rules`. The integrations must belong to the team of the maintenance window",
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	},
+	"policy_ids": schema.SetAttribute{
+		Optional:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "The IDs of the policies affected during the maintenance window, expanded into `rules`. The policies must belong to the team of the maintenance window, or be global alert policies when `team_id` is not set",
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	},
+	"sync_ids": schema.SetAttribute{
+		Optional:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "The IDs of the syncs affected during the maintenance window, expanded into `rules`",
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	},
+	"state": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The state to apply to the entities of `integration_ids`, `policy_ids` and `sync_ids` during maintenance (e.g., disabled, enabled, noMaintenance). Defaults to disabled",
+		Validators: []validator.String{
+			stringvalidator.OneOf("disabled", "enabled", "noMaintenance"),
+			stringvalidator.ConflictsWith(path.MatchRoot("rules")),
+		},
+	},
 	"rules": schema.ListNestedAttribute{
-		Required:            true,
-		MarkdownDescription: "A list of rules defining what entities are affected during the maintenance window",
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "A list of rules defining what entities are affected during the maintenance window. Either `rules`, or at least one of `integration_ids`, `policy_ids` and `sync_ids` must be set. Computed from them when they are set",
+		Validators: []validator.List{
+			listvalidator.AtLeastOneOf(path.MatchRoot("integration_ids"), path.MatchRoot("policy_ids"), path.MatchRoot("sync_ids")),
+			listvalidator.ConflictsWith(path.MatchRoot("integration_ids"), path.MatchRoot("policy_ids"), path.MatchRoot("sync_ids")),
+		},
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: map[string]schema.Attribute{
 				"state": schema.StringAttribute{
@@ -0,0 +1,160 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var NotificationPolicyDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the notification policy. Either id or name must be set to look up the notification policy.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		},
+	},
+	"type": schema.StringAttribute{
+		Computed:    true,
+		Description: "The type of the notification policy. Always 'notification'.",
+	},
+	"name": schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The name of the notification policy. Must match exactly one notification policy of the team when used for the lookup.",
+	},
+	"description": schema.StringAttribute{
+		Computed:    true,
+		Description: "The description of the notification policy",
+	},
+	"team_id": schema.StringAttribute{
+		Required:    true,
+		Description: "The ID of the team this notification policy belongs to",
+	},
+	"enabled": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether the notification policy is enabled",
+	},
+	"order": schema.Float64Attribute{
+		Computed:    true,
+		Description: "Order of the notification policy",
+	},
+	"filter": schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "The filter configuration for the notification policy",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the filter",
+			},
+			"conditions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of filter conditions",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: CriteriaConditionDataSourceAttributes,
+				},
+			},
+		},
+	},
+	"time_restriction": schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "Time restriction configuration for the notification policy",
+		Attributes:  PolicyTimeRestrictionDataSourceAttributes,
+	},
+	"suppress": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether notifications are suppressed for this policy",
+	},
+	"auto_restart_action": schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "Configuration for automatically restarting alerts",
+		Attributes: map[string]schema.Attribute{
+			"wait_duration": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Wait Duration amount for the auto-restart action",
+			},
+			"max_repeat_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Maximum number of times to repeat the restart",
+			},
+			"duration_format": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unit of the wait duration. One of 'nanos', 'micros', 'millis', 'seconds', 'minutes', 'hours', 'days'",
+			},
+		},
+	},
+	"auto_close_action": schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "Configuration for automatically closing alerts",
+		Attributes: map[string]schema.Attribute{
+			"wait_duration": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Wait Duration amount for the auto-close action",
+			},
+			"duration_format": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unit of the wait duration. One of 'nanos', 'micros', 'millis', 'seconds', 'minutes', 'hours', 'days'",
+			},
+		},
+	},
+	"deduplication_action": schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "Configuration for alert deduplication",
+		Attributes: map[string]schema.Attribute{
+			"deduplication_action_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of deduplication performed ('valueBased' or 'frequencyBased')",
+			},
+			"frequency": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Duration in seconds for the deduplication window",
+			},
+			"count_value_limit": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of alerts to trigger deduplication",
+			},
+			"wait_duration": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Wait Duration amount for the deduplication_action action",
+			},
+			"duration_format": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unit of the wait duration. One of 'nanos', 'micros', 'millis', 'seconds', 'minutes', 'hours', 'days'",
+			},
+		},
+	},
+	"delay_action": schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "Configuration for delaying alert notifications",
+		Attributes: map[string]schema.Attribute{
+			"delay_time": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Time of day the delay applies until",
+				Attributes: map[string]schema.Attribute{
+					"hours": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Number of hours to delay the alert",
+					},
+					"minutes": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Number of minutes to delay the alert",
+					},
+				},
+			},
+			"delay_option": schema.StringAttribute{
+				Computed:    true,
+				Description: "Option for how the delay is applied",
+			},
+			"wait_duration": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Wait Duration amount for the delay_action action",
+			},
+			"duration_format": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unit of the wait duration. One of 'nanos', 'micros', 'millis', 'seconds', 'minutes', 'hours', 'days'",
+			},
+		},
+	},
+}
@@ -67,41 +67,10 @@ var NotificationPolicyResourceAttributes = map[string]schema.Attribute{
 				Required:    true,
 				Description: "The type of the filter",
 			},
-			"conditions": schema.ListNestedAttribute{
+			"conditions": CriteriaConditionsAttribute(schema.ListNestedAttribute{
 				Required:    true,
 				Description: "List of filter conditions",
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"field": schema.StringAttribute{
-							Required:    true,
-							Description: "The field to filter on",
-						},
-						"key": schema.StringAttribute{
-							Optional:    true,
-							Description: "The key to filter on",
-						},
-						"not": schema.BoolAttribute{
-							Optional:    true,
-							Computed:    true,
-							Default:     booldefault.StaticBool(false),
-							Description: "Whether to negate the condition",
-						},
-						"operation": schema.StringAttribute{
-							Required:    true,
-							Description: "The operation to perform",
-						},
-						"expected_value": schema.StringAttribute{
-							Required:    true,
-							Description: "The expected value for the condition",
-						},
-						"order": schema.Int64Attribute{
-							Optional:    true,
-							Computed:    true,
-							Description: "The order of the condition",
-						},
-					},
-				},
-			},
+			}, nil, nil),
 		},
 	},
 	"time_restriction": schema.SingleNestedAttribute{
@@ -48,46 +48,10 @@ var NotificationRuleResourceAttributes = map[string]schema.Attribute{
 					stringvalidator.OneOf("match-all", "match-all-conditions", "match-any-condition"),
 				},
 			},
-			"conditions": schema.ListNestedAttribute{
-				Description: "List of conditions that must be met for the routing rule to be applied. Required if type is 'match-all-conditions' or 'match-any-condition'.",
+			"conditions": CriteriaConditionsAttribute(schema.ListNestedAttribute{
+				Description: "List of conditions that must be met for the notification rule to be applied. Required if type is 'match-all-conditions' or 'match-any-condition'.",
 				Optional:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"field": schema.StringAttribute{
-							Description: "The incident field to evaluate (e.g., 'message', 'priority', 'tags').",
-							Required:    true,
-							Validators: []validator.String{
-								stringvalidator.OneOf("message", "alias", "description", "source", "entity", "tags", "actions", "extra-properties", "priority", "details", "responders"),
-							},
-						},
-						"operation": schema.StringAttribute{
-							Description: "The comparison operation to perform (e.g., 'equals', 'contains', 'matches').",
-							Required:    true,
-						},
-						"expected_value": schema.StringAttribute{
-							Description: "The value to compare against the field value.",
-							Optional:    true,
-							Computed:    true,
-						},
-						"key": schema.StringAttribute{
-							Description: "If field is set as extra-properties, key could be used for key-value pair.",
-							Optional:    true,
-							Computed:    true,
-						},
-						"not": schema.BoolAttribute{
-							Description: "Indicates behaviour of the given operation.",
-							Optional:    true,
-							Computed:    true,
-							Default:     booldefault.StaticBool(false),
-						},
-						"order": schema.Int64Attribute{
-							Description: "Order of the condition in conditions list.",
-							Optional:    true,
-							Computed:    true,
-						},
-					},
-				},
-			},
+			}, CriteriaFields, nil),
 		},
 	},
 	"notification_time": schema.SetAttribute{
@@ -124,8 +88,9 @@ var NotificationRuleResourceAttributes = map[string]schema.Attribute{
 					Optional:    true,
 				},
 				"contact": schema.SingleNestedAttribute{
-					Description: "The contact information for this notification step.",
-					Required:    true,
+					Description: "The contact information for this notification step. Exactly one of contact or contact_id must be set, the contact is resolved from contact_id otherwise.",
+					Optional:    true,
+					Computed:    true,
 					Attributes: map[string]schema.Attribute{
 						"method": schema.StringAttribute{
 							Description: "The method of contact (e.g., email, sms, voice, mobile).",
@@ -140,6 +105,13 @@ var NotificationRuleResourceAttributes = map[string]schema.Attribute{
 						},
 					},
 				},
+				"contact_id": schema.StringAttribute{
+					Description: "The ID of an atlassian-operations_user_contact to notify. The method and recipient of the step are read from the contact, which should be enabled.",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("contact")),
+					},
+				},
 				"enabled": schema.BoolAttribute{
 					Description: "Whether this notification step is enabled.",
 					Optional:    true,
@@ -0,0 +1,47 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var PolicyOrderResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The identifier of the policy order, in the format policy_type,team_id for team policies and policy_type for global alert policies.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team whose policies are ordered. Omit it to order the global alert policies. Required for notification policies.",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"policy_type": schema.StringAttribute{
+		Description: "The type of the ordered policies. Valid values are 'alert' and 'notification'.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("alert", "notification"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"policy_ids": schema.ListAttribute{
+		Description: "The IDs of the policies, in the order they are evaluated. The listed policies are placed before the policies which are not listed, and moving another policy before them outside of Terraform is reported as a difference. The order attribute of the listed policy resources should not be set.",
+		ElementType: types.StringType,
+		Required:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+		},
+	},
+}
@@ -36,6 +36,10 @@ var ProviderAttributes = map[string]schema.Attribute{
 		Optional:    true,
 		Sensitive:   true,
 	},
+	"organization_id": schema.StringAttribute{
+		Description: "The unique identifier of your Atlassian organization. Required to resolve team names when importing resources by name, to resolve the escalation recipients set by `email` unless product_type is 'jira-service-desk', and to list the teams of the site when exporting it with the `-export` flag of the provider binary.",
+		Optional:    true,
+	},
 	"api_retry_count": schema.Int32Attribute{
 		Description: "The number of times to retry failed API requests. Defaults to 3.",
 		Optional:    true,
@@ -0,0 +1,103 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var RoutingRuleDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the routing rule. Either id or name must be set to look up the routing rule.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The unique identifier of the team that owns this routing rule.",
+		Required:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the routing rule. Must match exactly one routing rule of the team when used for the lookup.",
+		Optional:    true,
+		Computed:    true,
+	},
+	"order": schema.Int64Attribute{
+		Description: "The index of the routing rule within the team routing rules.",
+		Computed:    true,
+	},
+	"is_default": schema.BoolAttribute{
+		Description: "Indicates whether this is the default routing rule for the team.",
+		Computed:    true,
+	},
+	"timezone": schema.StringAttribute{
+		Description: "The timezone used for time-based routing decisions.",
+		Computed:    true,
+	},
+	"criteria": schema.SingleNestedAttribute{
+		Description: "The conditions that determine when this routing rule is applied to an incident.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "The type of criteria matching ('match-all', 'match-all-conditions' or 'match-any-condition').",
+				Computed:    true,
+			},
+			"conditions": schema.ListNestedAttribute{
+				Description: "List of conditions that must be met for the routing rule to be applied.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: CriteriaConditionDataSourceAttributes,
+				},
+			},
+		},
+	},
+	"time_restriction": schema.SingleNestedAttribute{
+		Description: "Time-based restrictions for when this routing rule is active.",
+		Computed:    true,
+		Attributes:  TimeRestrictionDataSourceAttributes,
+	},
+	"notify": schema.SingleNestedAttribute{
+		Description: "Configuration for how incidents matching this rule are handled.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "The type of notification ('none', 'escalation' or 'schedule').",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the escalation policy or schedule to notify.",
+				Computed:    true,
+			},
+		},
+	},
+}
+
+var CriteriaConditionDataSourceAttributes = map[string]schema.Attribute{
+	"field": schema.StringAttribute{
+		Description: "The incident field that is evaluated (e.g., 'message', 'priority', 'tags').",
+		Computed:    true,
+	},
+	"operation": schema.StringAttribute{
+		Description: "The comparison operation performed (e.g., 'equals', 'contains', 'matches').",
+		Computed:    true,
+	},
+	"expected_value": schema.StringAttribute{
+		Description: "The value the field value is compared against.",
+		Computed:    true,
+	},
+	"key": schema.StringAttribute{
+		Description: "If field is set as extra-properties, the key of the key-value pair.",
+		Computed:    true,
+	},
+	"not": schema.BoolAttribute{
+		Description: "Indicates whether the operation is negated.",
+		Computed:    true,
+	},
+	"order": schema.Int64Attribute{
+		Description: "Order of the condition in conditions list.",
+		Computed:    true,
+	},
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -82,46 +81,10 @@ var RoutingRuleResourceAttributes = map[string]schema.Attribute{
 					stringvalidator.OneOf("match-all", "match-any-condition", "match-all-conditions"),
 				},
 			},
-			"conditions": schema.ListNestedAttribute{
+			"conditions": CriteriaConditionsAttribute(schema.ListNestedAttribute{
 				Description: "List of conditions that must be met for the routing rule to be applied. Required if type is 'match-all-conditions' or 'match-any-condition'.",
 				Optional:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"field": schema.StringAttribute{
-							Description: "The incident field to evaluate (e.g., 'message', 'priority', 'tags').",
-							Required:    true,
-							Validators: []validator.String{
-								stringvalidator.OneOf("message", "alias", "description", "source", "entity", "tags", "actions", "extra-properties", "priority", "details", "responders"),
-							},
-						},
-						"operation": schema.StringAttribute{
-							Description: "The comparison operation to perform (e.g., 'equals', 'contains', 'matches').",
-							Required:    true,
-						},
-						"expected_value": schema.StringAttribute{
-							Description: "The value to compare against the field value.",
-							Optional:    true,
-							Computed:    true,
-						},
-						"key": schema.StringAttribute{
-							Description: "If field is set as extra-properties, key could be used for key-value pair.",
-							Optional:    true,
-							Computed:    true,
-						},
-						"not": schema.BoolAttribute{
-							Description: "Indicates behaviour of the given operation.",
-							Optional:    true,
-							Computed:    true,
-							Default:     booldefault.StaticBool(false),
-						},
-						"order": schema.Int64Attribute{
-							Description: "Order of the condition in conditions list.",
-							Optional:    true,
-							Computed:    true,
-						},
-					},
-				},
-			},
+			}, CriteriaFields, nil),
 		},
 	},
 	"time_restriction": schema.SingleNestedAttribute{
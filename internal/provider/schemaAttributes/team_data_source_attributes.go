@@ -73,4 +73,8 @@ var TeamMemberDataSourceAttributes = map[string]schema.Attribute{
 		Description: "The unique Atlassian account identifier for the team member.",
 		Computed:    true,
 	},
+	"role": schema.StringAttribute{
+		Description: "The JSM Operations role of the member in the team ('admin', 'user' or the name of a custom role).",
+		Computed:    true,
+	},
 }
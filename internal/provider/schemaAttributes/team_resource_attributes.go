@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -61,6 +62,12 @@ var TeamResourceAttributes = map[string]schema.Attribute{
 			setvalidator.SizeAtLeast(1),
 		},
 	},
+	"authoritative_membership": schema.BoolAttribute{
+		Description: "Whether the member set is authoritative. When true (the default), members not listed in member are removed from the team. When false, only the listed members are managed and other members, e.g. the ones managed with atlassian-operations_team_member, are ignored.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	},
 }
 
 var PublicApiUserPermissionsResourceAttributes = map[string]schema.Attribute{
@@ -95,4 +102,11 @@ var TeamMemberResourceAttributes = map[string]schema.Attribute{
 		Description: "The unique Atlassian account identifier for the team member. This is used to uniquely identify users across Atlassian products.",
 		Required:    true,
 	},
+	"role": schema.StringAttribute{
+		Description: "The JSM Operations role of the member in the team. Valid values are 'admin', 'user' or the name of a custom role (see atlassian-operations_custom_role). When omitted, the role of the member is not managed by Terraform.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
 }
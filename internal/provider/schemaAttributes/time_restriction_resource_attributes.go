@@ -7,8 +7,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+// Weekdays lists the days accepted by time restrictions, in the order of the week.
+var Weekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// RestrictionMinutes lists the minutes accepted by time restrictions.
+var RestrictionMinutes = []int32{0, 30}
+
 var weekdayValidator = []validator.String{
-	stringvalidator.OneOf([]string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}...),
+	stringvalidator.OneOf(Weekdays...),
 }
 
 var hourValidator = []validator.Int32{
@@ -17,7 +23,7 @@ var hourValidator = []validator.Int32{
 }
 
 var minuteValidator = []validator.Int32{
-	int32validator.OneOf([]int32{0, 30}...),
+	int32validator.OneOf(RestrictionMinutes...),
 }
 
 var TimeRestrictionResourceAttributes = map[string]schema.Attribute{
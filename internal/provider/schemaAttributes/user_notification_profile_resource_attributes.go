@@ -0,0 +1,113 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// UserNotificationProfileActionTypes lists the action types the notification rules of a profile can be created for.
+var UserNotificationProfileActionTypes = []string{"create-alert", "acknowledged-alert", "closed-alert", "assigned-alert", "add-note"}
+
+var UserNotificationProfileResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the notification profile, which is its name.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the notification profile. The notification rules of the profile are named after it and their action type.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"contacts": schema.MapNestedAttribute{
+		Description: "The contacts of the user, by a name the steps of the rules reference them with.",
+		Required:    true,
+		Validators: []validator.Map{
+			mapvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "The unique identifier of the user contact.",
+					Computed:    true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"method": schema.StringAttribute{
+					Description: "The method of contact. Valid values are 'email', 'sms', 'voice', or 'mobile'. Changing the method creates a new contact.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("email", "sms", "voice", "mobile"),
+					},
+				},
+				"to": schema.StringAttribute{
+					Description: "The recipient of the notifications, such as an email address or phone number.",
+					Required:    true,
+				},
+				"enabled": schema.BoolAttribute{
+					Description: "Whether the contact is enabled.",
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(true),
+				},
+			},
+		},
+	},
+	"rules": schema.MapNestedAttribute{
+		Description: "The notification rules of the user, by action type. Valid action types are: create-alert, acknowledged-alert, closed-alert, assigned-alert, add-note.",
+		Required:    true,
+		Validators: []validator.Map{
+			mapvalidator.KeysAre(stringvalidator.OneOf(UserNotificationProfileActionTypes...)),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "The unique identifier of the notification rule.",
+					Computed:    true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"steps": schema.ListNestedAttribute{
+					Description: "The notification steps of the rule.",
+					Required:    true,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"contact": schema.StringAttribute{
+								Description: "The name of the contact to notify, a key of contacts.",
+								Required:    true,
+							},
+							"send_after": schema.Int64Attribute{
+								Description: "The number of minutes to wait before sending this notification after the rule is triggered. Only for the create-alert and assigned-alert action types.",
+								Optional:    true,
+							},
+						},
+					},
+				},
+				"enabled": schema.BoolAttribute{
+					Description: "Whether the notification rule is enabled.",
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(true),
+				},
+			},
+		},
+	},
+}
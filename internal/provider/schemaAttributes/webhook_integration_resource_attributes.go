@@ -0,0 +1,114 @@
+package schemaAttributes
+
+import (
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes/customValidators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+)
+
+var WebhookIntegrationResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the webhook integration. This is automatically generated when the integration is created.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the webhook integration. Must be between 1 and 250 characters.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 250),
+		},
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the webhook integration is enabled. When disabled, no alerts are forwarded to the webhook. Defaults to true.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns this webhook integration. Cannot be changed after creation.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"advanced": schema.BoolAttribute{
+		Description: "Indicates whether this is an advanced webhook integration with additional configuration options.",
+		Computed:    true,
+	},
+	"maintenance_sources": schema.ListNestedAttribute{
+		Description: "List of maintenance windows associated with this webhook integration.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: EmailIntegrationMaintenanceSourceResourceAttributes,
+		},
+	},
+	"directions": schema.ListAttribute{
+		Description: "The communication directions supported by this integration. Webhook integrations are always 'outgoing'.",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"domains": schema.ListAttribute{
+		Description: "The domains this integration operates on.",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"url": schema.StringAttribute{
+		Description: "The URL alert payloads are posted to. Must be an http or https URL.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(regexp.MustCompile(`^https?://\S+$`), "must be an http or https URL"),
+		},
+	},
+	"headers": schema.MapAttribute{
+		Description: "Custom headers sent with every webhook request, e.g. for authentication. Values are treated as sensitive.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Sensitive:   true,
+	},
+	"add_alert_description": schema.BoolAttribute{
+		Description: "Whether the alert description is included in the webhook payload. Defaults to true.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	},
+	"add_alert_details": schema.BoolAttribute{
+		Description: "Whether the alert details (extra properties) are included in the webhook payload. Defaults to true.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	},
+	"alert_filter": schema.SingleNestedAttribute{
+		Description: "The conditions an alert must match to be forwarded to the webhook. All alerts are forwarded when omitted.",
+		Optional:    true,
+		Validators: []validator.Object{
+			customValidators.ListFieldNullIfOtherField(path.MatchRelative().AtName("conditions"), path.MatchRelative().AtName("type"), "match-all"),
+			customValidators.ListFieldNotNullIfOtherField(path.MatchRelative().AtName("conditions"), path.MatchRelative().AtName("type"), "match-all-conditions"),
+			customValidators.ListFieldNotNullIfOtherField(path.MatchRelative().AtName("conditions"), path.MatchRelative().AtName("type"), "match-any-condition"),
+		},
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "The type of filter matching to use. Valid values are: 'match-all' (forwards all alerts), 'match-all-conditions' (all conditions must match), or 'match-any-condition' (any condition can match).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("match-all", "match-any-condition", "match-all-conditions"),
+				},
+			},
+			"conditions": CriteriaConditionsAttribute(schema.ListNestedAttribute{
+				Description: "List of conditions alerts are matched against. Required if type is 'match-all-conditions' or 'match-any-condition'.",
+				Optional:    true,
+			}, CriteriaFields, nil),
+		},
+	},
+}
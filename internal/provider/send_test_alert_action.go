@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const testAlertSource = "terraform"
+
+var _ action.Action = &SendTestAlertAction{}
+var _ action.ActionWithConfigure = &SendTestAlertAction{}
+
+func NewSendTestAlertAction() action.Action {
+	return &SendTestAlertAction{}
+}
+
+// SendTestAlertAction creates an alert through an API integration, so that the routing, escalations and
+// notifications configured for a team can be verified end to end.
+type SendTestAlertAction struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (a *SendTestAlertAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_send_test_alert"
+}
+
+func (a *SendTestAlertAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a test alert through an API integration.",
+		Attributes:  schemaAttributes.SendTestAlertActionAttributes,
+	}
+}
+
+func (a *SendTestAlertAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	a.clientConfiguration = client
+}
+
+func (a *SendTestAlertAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Trace(ctx, "Invoking the send_test_alert action")
+
+	var data dataModels.SendTestAlertActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alert := dto.CreateAlertDto{
+		Message:     data.Message.ValueString(),
+		Alias:       data.Alias.ValueString(),
+		Description: data.Description.ValueString(),
+		Priority:    data.Priority.ValueString(),
+		Source:      testAlertSource,
+	}
+	if !data.Tags.IsNull() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &alert.Tags, false)...)
+	}
+	if !data.Details.IsNull() {
+		resp.Diagnostics.Append(data.Details.ElementsAs(ctx, &alert.Details, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := dto.CreateAlertResponseDto{}
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsIntegrationClientRequest(a.clientConfiguration, data.ApiKey.ValueString()).
+		JoinBaseUrl("v2/alerts").
+		Method(httpClient.POST).
+		SetBody(alert).
+		SetBodyParseObject(&result).
+		Send()
+
+	handleHttpResponse(httpResp, err, "send test alert", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Test alert request accepted, request id: %s", result.RequestId),
+	})
+
+	tflog.Trace(ctx, "Invoked the send_test_alert action")
+}
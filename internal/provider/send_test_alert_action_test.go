@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSendTestAlertAction(t *testing.T) {
+	teamName := uuid.NewString()
+	alertAlias := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		// Actions are only supported from Terraform 1.14 onwards
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invoke the action after the trigger is created
+			{
+				Config: providerConfig + testAccSendTestAlertActionConfig(teamName, emailPrimary, organizationId, alertAlias),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("atlassian-operations_api_integration.example", "api_key"),
+					testAccCheckTestAlertCreated("atlassian-operations_api_integration.example", alertAlias, "Terraform test alert"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckTestAlertCreated looks the alert up by its alias through the integration API, with the API key of the
+// integration. Alerts are created asynchronously, so the lookup is retried for a while.
+func testAccCheckTestAlertCreated(integrationResourceName string, alias string, message string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		integration, ok := s.RootModule().Resources[integrationResourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", integrationResourceName)
+		}
+
+		var lastStatusCode int
+		for attempt := 0; attempt < 10; attempt++ {
+			var alert struct {
+				Data struct {
+					Alias   string `json:"alias"`
+					Message string `json:"message"`
+				} `json:"data"`
+			}
+			httpResp, err := httpClientHelpers.
+				GenerateJsmOpsIntegrationClientRequest(testAccClientConfiguration(), integration.Primary.Attributes["api_key"]).
+				JoinBaseUrl(fmt.Sprintf("v2/alerts/%s", alias)).
+				SetQueryParam("identifierType", "alias").
+				Method(httpClient.GET).
+				SetBodyParseObject(&alert).
+				Send()
+			if httpResp == nil {
+				return fmt.Errorf("unable to read the test alert %s, got error: %v", alias, err)
+			}
+
+			lastStatusCode = httpResp.GetStatusCode()
+			if !httpResp.IsError() {
+				if alert.Data.Message != message {
+					return fmt.Errorf("expected the test alert %s to have the message '%s', got '%s'", alias, message, alert.Data.Message)
+				}
+				return nil
+			} else if lastStatusCode != 404 {
+				return fmt.Errorf("unable to read the test alert %s, status code: %d", alias, lastStatusCode)
+			}
+			time.Sleep(3 * time.Second)
+		}
+		return fmt.Errorf("the test alert %s was not created, last status code: %d", alias, lastStatusCode)
+	}
+}
+
+func testAccSendTestAlertActionConfig(teamName string, emailPrimary string, organizationId string, alertAlias string) string {
+	return `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+  	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_api_integration" "example" {
+  name    = "` + teamName + `-api"
+  team_id = atlassian-operations_team.example.id
+  type    = "API"
+  enabled = true
+  type_specific_properties = jsonencode({
+    suppressNotifications: true
+  })
+}
+
+action "atlassian-operations_send_test_alert" "test" {
+  config {
+    api_key  = atlassian-operations_api_integration.example.api_key
+    message  = "Terraform test alert"
+    alias    = "` + alertAlias + `"
+    priority = "P5"
+    tags     = ["terraform", "test"]
+    details = {
+      source = "acceptance-test"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = atlassian-operations_api_integration.example.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.atlassian-operations_send_test_alert.test]
+    }
+  }
+}
+`
+}
@@ -143,9 +143,25 @@ func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	tflog.Trace(ctx, "Fetching team member roles from JSM Ops API")
+
+	members := memberData.Results
+	memberRoles, err := fetchAllPages[dto.TeamMemberRoleDto](d.clientConfiguration, fmt.Sprintf("v1/teams/%s/members", model.Id.ValueString()), nil)
+	if err != nil {
+		// Teams without Operations enabled have no roles, the members are still returned
+		tflog.Warn(ctx, fmt.Sprintf("Unable to read team member roles, got error: %s", err))
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Unable to read team member roles, got error: %s", err))
+	} else {
+		roles := make(map[string]string, len(memberRoles))
+		for _, memberRole := range memberRoles {
+			roles[memberRole.AccountId] = memberRole.Role
+		}
+		members = applyRoles(members, roles)
+	}
+
 	tflog.Trace(ctx, "Converting Team Data into Terraform Model")
 	// Convert the fetched data into the model
-	model = TeamDtoToModel(data, memberData.Results)
+	model = TeamDtoToModel(data, members)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &TeamListResource{}
+var _ list.ListResourceWithConfigure = &TeamListResource{}
+
+func NewTeamListResource() list.ListResource {
+	return &TeamListResource{}
+}
+
+// TeamListResource lists the teams of an organization.
+// The metadata, configuration and read logic are shared with TeamResource.
+type TeamListResource struct {
+	TeamResource
+}
+
+func (r *TeamListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.TeamListResourceAttributes,
+	}
+}
+
+func (r *TeamListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data dataModels.TeamListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Trace(ctx, "Listing teams")
+
+	teams, err := fetchTeams(r.clientConfiguration, data.OrganizationId.ValueString())
+	if err != nil {
+		stream.Results = listError(ctx, "Unable to list teams", err)
+		return
+	}
+
+	listed := make([]listedResource, 0, len(teams))
+	for _, item := range teams {
+		listed = append(listed, listedResource{
+			displayName: item.DisplayName,
+			identity: map[string]types.String{
+				"id":              types.StringValue(item.TeamId),
+				"organization_id": data.OrganizationId,
+			},
+		})
+	}
+
+	stream.Results = listResults(ctx, req, &r.TeamResource, listed)
+}
+
+// fetchTeams reads every page of the teams of an organization, following the cursor returned by the Teams API.
+func fetchTeams(clientConfiguration dto.AtlassianOpsProviderModel, organizationId string) ([]dto.TeamDto, error) {
+	var teams []dto.TeamDto
+
+	cursor := ""
+	for {
+		response := dto.TeamListResponse{}
+
+		queryParams := map[string]string{}
+		if cursor != "" {
+			queryParams["cursor"] = cursor
+		}
+
+		httpResp, err := httpClientHelpers.
+			GenerateTeamsClientRequest(clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("%s/teams", organizationId)).
+			Method(httpClient.GET).
+			SetQueryParams(queryParams).
+			SetBodyParseObject(&response).
+			Send()
+
+		if err != nil {
+			return nil, err
+		} else if httpResp == nil {
+			return nil, fmt.Errorf("got nil response while fetching teams")
+		} else if httpResp.IsError() {
+			statusCode := httpResp.GetStatusCode()
+			errorResponse := httpResp.GetErrorBody()
+			if errorResponse != nil {
+				return nil, fmt.Errorf("error while fetching teams. Status Code: %d. Got response: %s", statusCode, *errorResponse)
+			}
+			return nil, fmt.Errorf("error while fetching teams. Status Code: %d", statusCode)
+		}
+
+		teams = append(teams, response.Entities...)
+		if response.Cursor == "" || response.Cursor == cursor {
+			break
+		}
+		cursor = response.Cursor
+	}
+	return teams, nil
+}
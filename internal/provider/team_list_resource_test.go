@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTeamListResource(t *testing.T) {
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		// List resources are only supported from Terraform 1.14 onwards
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the teams to list
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "first" {
+  organization_id = "` + organizationId + `"
+  description = "First team listed by Terraform"
+  display_name = "` + teamName + `-1"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_team" "second" {
+  organization_id = "` + organizationId + `"
+  description = "Second team listed by Terraform"
+  display_name = "` + teamName + `-2"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+`,
+			},
+			// List the teams of the organization, which may have more teams than the default limit
+			{
+				Query: true,
+				Config: `
+list "atlassian-operations_team" "test" {
+  provider = atlassian-operations
+  limit    = 1000
+
+  config {
+    organization_id = "` + organizationId + `"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("atlassian-operations_team.test", 2),
+					querycheck.ExpectResourceDisplayName("atlassian-operations_team.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact(teamName+"-1")), knownvalue.StringExact(teamName+"-1")),
+					querycheck.ExpectResourceDisplayName("atlassian-operations_team.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact(teamName+"-2")), knownvalue.StringExact(teamName+"-2")),
+				},
+			},
+			// List a single team along with its resource
+			{
+				Query: true,
+				Config: `
+list "atlassian-operations_team" "test" {
+  provider         = atlassian-operations
+  include_resource = true
+  limit            = 1
+
+  config {
+    organization_id = "` + organizationId + `"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("atlassian-operations_team.test", 1),
+				},
+			},
+		},
+	})
+}
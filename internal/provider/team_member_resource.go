@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamMemberResource{}
+var _ resource.ResourceWithImportState = &TeamMemberResource{}
+var _ resource.ResourceWithIdentity = &TeamMemberResource{}
+
+func NewTeamMemberResource() resource.Resource {
+	return &TeamMemberResource{}
+}
+
+// TeamMemberResource defines the resource implementation.
+type TeamMemberResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (r *TeamMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+func (r *TeamMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.TeamMembershipResourceAttributes,
+	}
+}
+
+func (r *TeamMemberResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.TeamMemberIdentityAttributes,
+	}
+}
+
+func (r *TeamMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring TeamMemberResource")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Unexpected Resource Configure Type")
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+
+	tflog.Trace(ctx, "Configured TeamMemberResource")
+}
+
+func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating the TeamMemberResource")
+
+	var data dataModels.TeamMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberAddResponse := dto.PublicApiMembershipAddResponse{}
+	httpResp, err := httpClientHelpers.
+		GenerateTeamsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/teams/%s/members/add", data.OrganizationId.ValueString(), data.TeamId.ValueString())).
+		Method(httpClient.POST).
+		SetBody(dto.TeamMemberList{Members: []dto.TeamMember{{AccountId: data.AccountId.ValueString()}}}).
+		SetBodyParseObject(&memberAddResponse).
+		Send()
+
+	if httpResp == nil {
+		tflog.Error(ctx, "Client Error. Unable to add the user to the team, got nil response")
+		resp.Diagnostics.AddError("Client Error", "Unable to add the user to the team, got nil response")
+	} else if httpResp.IsError() {
+		statusCode := httpResp.GetStatusCode()
+		errorResponse := httpResp.GetErrorBody()
+		if errorResponse != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to add the user to the team, status code: %d. Got response: %s", statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add the user to the team, status code: %d. Got response: %s", statusCode, *errorResponse))
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to add the user to the team, got http response: %d", statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add the user to the team, got http response: %d", statusCode))
+		}
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to add the user to the team, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add the user to the team, got error: %s", err))
+	} else if len(memberAddResponse.Errors) > 0 {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to add the user to the team, got errors: %v", memberAddResponse.Errors))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add the user to the team, got errors: %v", memberAddResponse.Errors))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s,%s", data.TeamId.ValueString(), data.AccountId.ValueString()))
+
+	tflog.Trace(ctx, "Created the TeamMemberResource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+	tflog.Trace(ctx, "Saved the TeamMemberResource into Terraform state")
+}
+
+func (r *TeamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dataModels.TeamMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Reading the TeamMemberResource")
+
+	members, err := fetchTeamMembers(r.clientConfiguration, data.OrganizationId.ValueString(), data.TeamId.ValueString())
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to fetch members of the team, %s", err.Error()))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch members of the team, %s", err.Error()))
+		return
+	}
+
+	if _, found := findMemberRole(members, data.AccountId.ValueString()); !found {
+		tflog.Trace(ctx, "The user is no longer a member of the team, removing the TeamMemberResource from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s,%s", data.TeamId.ValueString(), data.AccountId.ValueString()))
+
+	tflog.Trace(ctx, "Read the TeamMemberResource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+	tflog.Trace(ctx, "Saved the TeamMemberResource into Terraform state")
+}
+
+func (r *TeamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so there is nothing to update in place
+	var data dataModels.TeamMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *TeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data dataModels.TeamMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting the TeamMemberResource")
+
+	removeMemberResponse := dto.PublicApiMembershipRemoveResponse{}
+	httpResp, err := httpClientHelpers.
+		GenerateTeamsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/teams/%s/members/remove", data.OrganizationId.ValueString(), data.TeamId.ValueString())).
+		Method(httpClient.POST).
+		SetBody(dto.TeamMemberList{Members: []dto.TeamMember{{AccountId: data.AccountId.ValueString()}}}).
+		SetBodyParseObject(&removeMemberResponse).
+		Send()
+
+	if httpResp == nil {
+		tflog.Error(ctx, "Client Error. Unable to remove the user from the team, got nil response")
+		resp.Diagnostics.AddError("Client Error", "Unable to remove the user from the team, got nil response")
+	} else if httpResp.IsError() {
+		statusCode := httpResp.GetStatusCode()
+		errorResponse := httpResp.GetErrorBody()
+		if errorResponse != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to remove the user from the team, status code: %d. Got response: %s", statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove the user from the team, status code: %d. Got response: %s", statusCode, *errorResponse))
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to remove the user from the team, got http response: %d", statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove the user from the team, got http response: %d", statusCode))
+		}
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to remove the user from the team, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove the user from the team, got error: %s", err))
+	} else if len(removeMemberResponse.Errors) > 0 {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to remove the user from the team, got errors: %v", removeMemberResponse.Errors))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove the user from the team, got errors: %v", removeMemberResponse.Errors))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted the TeamMemberResource")
+}
+
+func (r *TeamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: team_id,account_id,organization_id. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[2])...)
+}
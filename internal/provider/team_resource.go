@@ -11,16 +11,22 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"strings"
 )
 
+const teamAdminRole = "admin"
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TeamResource{}
 var _ resource.ResourceWithImportState = &TeamResource{}
+var _ resource.ResourceWithIdentity = &TeamResource{}
 
 func NewTeamResource() resource.Resource {
 	return &TeamResource{}
@@ -41,6 +47,12 @@ func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (r *TeamResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.TeamIdentityAttributes,
+	}
+}
+
 func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring TeamResource")
 
@@ -68,11 +80,11 @@ func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequ
 func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "Creating the TeamResource")
 
-	var data dataModels.TeamModel
+	var data dataModels.TeamResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-	teamDto, membersDto := TeamModelToDto(ctx, data)
+	teamDto, membersDto := TeamModelToDto(ctx, data.TeamModel)
 
 	tflog.Trace(ctx, "Creating the Team")
 
@@ -110,7 +122,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	tflog.Trace(ctx, "Team created")
 	tflog.Trace(ctx, "Fetch auto created members")
 
-	autoAddedMembers, err := r.fetchTeamMembers(teamDto.OrganizationId, teamDto.TeamId)
+	autoAddedMembers, err := fetchTeamMembers(r.clientConfiguration, teamDto.OrganizationId, teamDto.TeamId)
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to fetch members for the created team, %s", err.Error()))
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch members for the created team, %s", err.Error()))
@@ -122,6 +134,10 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	addedUsers, removedUsers := diffUsers(membersDto, autoAddedMembers)
+	if !data.AuthoritativeMembership.ValueBool() {
+		// Members which are not listed are left untouched in the non-authoritative mode
+		removedUsers = make([]dto.TeamMember, 0)
+	}
 
 	if len(addedUsers) > 0 {
 		tflog.Trace(ctx, "Adding users to the team")
@@ -208,9 +224,20 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	tflog.Trace(ctx, "Extra users removed from the team")
 
 	tflog.Trace(ctx, "Enabling Operations for the Team")
+	adminAccountIds := make([]string, 0)
+	for _, member := range membersDto {
+		if member.Role == teamAdminRole {
+			adminAccountIds = append(adminAccountIds, member.AccountId)
+		}
+	}
+	if len(adminAccountIds) == 0 {
+		// No explicit admin configured, the first member becomes the admin of the team
+		adminAccountIds = append(adminAccountIds, membersDto[0].AccountId)
+	}
+
 	enableOpsBody := dto.TeamEnableOps{
 		TeamId:          teamDto.TeamId,
-		AdminAccountIds: []string{membersDto[0].AccountId},
+		AdminAccountIds: adminAccountIds,
 		InviteUsernames: make([]string, 0),
 	}
 
@@ -256,22 +283,50 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 	tflog.Trace(ctx, "Enabled Operations for the Team")
 
-	data = TeamDtoToModel(teamDto, membersDto)
+	if hasManagedRoles(membersDto) {
+		tflog.Trace(ctx, "Setting the roles of the team members")
+
+		currentRoles, err := r.fetchMemberRoles(teamDto.TeamId)
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to fetch member roles for the created team, %s", err.Error()))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch member roles for the created team, %s", err.Error()))
+		} else {
+			resp.Diagnostics.Append(r.updateMemberRoles(ctx, teamDto.TeamId, diffRoles(membersDto, applyRoles(membersDto, currentRoles)))...)
+		}
+
+		if resp.Diagnostics.HasError() {
+			tflog.Trace(ctx, "Deleting dangling team resource")
+			r.cleanupTeamSilent(teamDto)
+			return
+		}
+		tflog.Trace(ctx, "Set the roles of the team members")
+	}
+
+	data.TeamModel = TeamDtoToModel(teamDto, membersDto)
 
 	tflog.Trace(ctx, "Created the TeamResource")
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the TeamResource into Terraform state")
 }
 
 func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data dataModels.TeamModel
+	var data dataModels.TeamResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	tflog.Trace(ctx, "Reading the TeamResource")
 
+	// Roles are only read back for the members whose role is managed by Terraform
+	_, priorMembers := TeamModelToDto(ctx, data.TeamModel)
+
+	if data.AuthoritativeMembership.IsNull() {
+		// Imported teams are managed authoritatively by default
+		data.AuthoritativeMembership = types.BoolValue(true)
+	}
+
 	teamDto := dto.TeamDto{}
 
 	httpResp, err := httpClientHelpers.
@@ -306,7 +361,7 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	tflog.Trace(ctx, "Fetching team members")
 
-	memberData, err := r.fetchTeamMembers(data.OrganizationId.ValueString(), data.Id.ValueString())
+	memberData, err := fetchTeamMembers(r.clientConfiguration, data.OrganizationId.ValueString(), data.Id.ValueString())
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to fetch members for the created team, %s", err.Error()))
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch members for the created team, %s", err.Error()))
@@ -316,19 +371,49 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 	tflog.Trace(ctx, "Done fetching team members")
 
+	if !data.AuthoritativeMembership.ValueBool() {
+		managedMembers := make([]dto.TeamMember, 0, len(priorMembers))
+		for _, member := range memberData {
+			if _, found := findMemberRole(priorMembers, member.AccountId); found {
+				managedMembers = append(managedMembers, member)
+			}
+		}
+		memberData = managedMembers
+	}
+
+	if hasManagedRoles(priorMembers) {
+		tflog.Trace(ctx, "Fetching team member roles")
+
+		roles, err := r.fetchMemberRoles(data.Id.ValueString())
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to fetch member roles for the team, %s", err.Error()))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch member roles for the team, %s", err.Error()))
+			return
+		}
+
+		memberData = applyRoles(memberData, roles)
+		for i, member := range memberData {
+			if role, found := findMemberRole(priorMembers, member.AccountId); !found || role == "" {
+				memberData[i].Role = ""
+			}
+		}
+		tflog.Trace(ctx, "Done fetching team member roles")
+	}
+
 	tflog.Trace(ctx, "Converting Team Data into Terraform Model")
 
-	data = TeamDtoToModel(teamDto, memberData)
+	data.TeamModel = TeamDtoToModel(teamDto, memberData)
 
 	tflog.Trace(ctx, "Read the TeamResource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the TeamResource into Terraform state")
 }
 
 func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var currentData dataModels.TeamModel
-	var newData dataModels.TeamModel
+	var currentData dataModels.TeamResourceModel
+	var newData dataModels.TeamResourceModel
 
 	req.State.Get(ctx, &currentData)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &newData)...)
@@ -349,8 +434,8 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	tflog.Trace(ctx, "Updating the TeamResource")
 
-	newTeamDto, newUsersDto := TeamModelToDto(ctx, newData)
-	_, currentUsersDto := TeamModelToDto(ctx, currentData)
+	newTeamDto, newUsersDto := TeamModelToDto(ctx, newData.TeamModel)
+	_, currentUsersDto := TeamModelToDto(ctx, currentData.TeamModel)
 
 	httpResp, err := httpClientHelpers.
 		GenerateTeamsClientRequest(r.clientConfiguration).
@@ -457,7 +542,17 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
-	newData = TeamDtoToModel(newTeamDto, newUsersDto)
+	changedRoles := diffRoles(newUsersDto, currentUsersDto)
+	if len(changedRoles) > 0 {
+		tflog.Trace(ctx, "Updating the roles of the team members")
+		resp.Diagnostics.Append(r.updateMemberRoles(ctx, newData.Id.ValueString(), changedRoles)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	newData.TeamModel = TeamDtoToModel(newTeamDto, newUsersDto)
 
 	tflog.Trace(ctx, "Updated the TeamResource")
 
@@ -466,11 +561,12 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newData)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Trace(ctx, "Saved the TeamResource into Terraform state")
 }
 
 func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data dataModels.TeamModel
+	var data dataModels.TeamResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -510,6 +606,12 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
@@ -522,7 +624,7 @@ func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportState
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[1])...)
 }
 
-func (r *TeamResource) fetchTeamMembers(organizationId string, teamId string) ([]dto.TeamMember, error) {
+func fetchTeamMembers(clientConfiguration dto.AtlassianOpsProviderModel, organizationId string, teamId string) ([]dto.TeamMember, error) {
 	var members []dto.TeamMember
 
 	doneLooping := false
@@ -531,7 +633,7 @@ func (r *TeamResource) fetchTeamMembers(organizationId string, teamId string) ([
 		response := dto.TeamMemberListResponse{}
 
 		httpResp, err := httpClientHelpers.
-			GenerateTeamsClientRequest(r.clientConfiguration).
+			GenerateTeamsClientRequest(clientConfiguration).
 			JoinBaseUrl(fmt.Sprintf("/%s/teams/%s/members", organizationId, teamId)).
 			Method("POST").
 			SetBody(request).
@@ -560,6 +662,58 @@ func (r *TeamResource) fetchTeamMembers(organizationId string, teamId string) ([
 	return members, nil
 }
 
+// fetchMemberRoles returns the JSM Operations role of every member of the team, keyed by account ID.
+func (r *TeamResource) fetchMemberRoles(teamId string) (map[string]string, error) {
+	memberRoles, err := fetchAllPages[dto.TeamMemberRoleDto](r.clientConfiguration, fmt.Sprintf("v1/teams/%s/members", teamId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make(map[string]string, len(memberRoles))
+	for _, memberRole := range memberRoles {
+		roles[memberRole.AccountId] = memberRole.Role
+	}
+	return roles, nil
+}
+
+func (r *TeamResource) updateMemberRoles(ctx context.Context, teamId string, members []dto.TeamMember) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, member := range members {
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(r.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("v1/teams/%s/members/%s", teamId, member.AccountId)).
+			Method(httpClient.PATCH).
+			SetBody(dto.TeamMemberRoleUpdate{Role: member.Role}).
+			Send()
+
+		if httpResp == nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to update the role of team member %s, got nil response", member.AccountId))
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update the role of team member %s, got nil response", member.AccountId))
+		} else if httpResp.IsError() {
+			statusCode := httpResp.GetStatusCode()
+			errorResponse := httpResp.GetErrorBody()
+			if errorResponse != nil {
+				tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to update the role of team member %s, status code: %d. Got response: %s", member.AccountId, statusCode, *errorResponse))
+				diags.AddError("Client Error", fmt.Sprintf("Unable to update the role of team member %s, status code: %d. Got response: %s", member.AccountId, statusCode, *errorResponse))
+			} else {
+				tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to update the role of team member %s, got http response: %d", member.AccountId, statusCode))
+				diags.AddError("Client Error", fmt.Sprintf("Unable to update the role of team member %s, got http response: %d", member.AccountId, statusCode))
+			}
+		}
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to update the role of team member %s, got error: %s", member.AccountId, err))
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update the role of team member %s, got error: %s", member.AccountId, err))
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
 func (r *TeamResource) cleanupTeamSilent(teamDto dto.TeamDto) {
 	_, _ = httpClientHelpers.
 		GenerateTeamsClientRequest(r.clientConfiguration).
@@ -602,3 +756,50 @@ func diffUsers(newDto []dto.TeamMember, oldDto []dto.TeamMember) ([]dto.TeamMemb
 
 	return addedUsers, removedUsers
 }
+
+// diffRoles returns the members of newDto whose role is managed and differs from their role in oldDto.
+func diffRoles(newDto []dto.TeamMember, oldDto []dto.TeamMember) []dto.TeamMember {
+	changedRoles := make([]dto.TeamMember, 0)
+
+	for _, user := range newDto {
+		if user.Role == "" {
+			continue
+		}
+
+		if role, found := findMemberRole(oldDto, user.AccountId); !found || role != user.Role {
+			changedRoles = append(changedRoles, user)
+		}
+	}
+
+	return changedRoles
+}
+
+// applyRoles returns a copy of members with the roles set from the given account ID to role map.
+func applyRoles(members []dto.TeamMember, roles map[string]string) []dto.TeamMember {
+	result := make([]dto.TeamMember, len(members))
+	for i, member := range members {
+		result[i] = dto.TeamMember{
+			AccountId: member.AccountId,
+			Role:      roles[member.AccountId],
+		}
+	}
+	return result
+}
+
+func findMemberRole(members []dto.TeamMember, accountId string) (string, bool) {
+	for _, member := range members {
+		if member.AccountId == accountId {
+			return member.Role, true
+		}
+	}
+	return "", false
+}
+
+func hasManagedRoles(members []dto.TeamMember) bool {
+	for _, member := range members {
+		if member.Role != "" {
+			return true
+		}
+	}
+	return false
+}
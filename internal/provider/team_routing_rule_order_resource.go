@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"sort"
+)
+
+var _ resource.Resource = &TeamRoutingRuleOrderResource{}
+var _ resource.ResourceWithImportState = &TeamRoutingRuleOrderResource{}
+var _ resource.ResourceWithIdentity = &TeamRoutingRuleOrderResource{}
+
+func NewTeamRoutingRuleOrderResource() resource.Resource {
+	return &TeamRoutingRuleOrderResource{}
+}
+
+type TeamRoutingRuleOrderResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (r *TeamRoutingRuleOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_routing_rule_order"
+}
+
+func (r *TeamRoutingRuleOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.TeamRoutingRuleOrderResourceAttributes,
+	}
+}
+
+func (r *TeamRoutingRuleOrderResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.TeamRoutingRuleOrderIdentityAttributes,
+	}
+}
+
+func (r *TeamRoutingRuleOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+}
+
+func (r *TeamRoutingRuleOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data dataModels.TeamRoutingRuleOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyOrder(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *TeamRoutingRuleOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dataModels.TeamRoutingRuleOrderModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentOrder, err := r.fetchOrder(data.TeamId.ValueString())
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read routing rules, %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read routing rules, %s", err))
+		return
+	}
+
+	routingRuleIds := currentOrder
+	if !data.RoutingRuleIds.IsNull() {
+		// Only the leading routing rules, at the positions of the managed ones, are tracked, so that reordering them outside
+		// of Terraform or moving another routing rule before them shows up as a diff
+		var managedIds []string
+		resp.Diagnostics.Append(data.RoutingRuleIds.ElementsAs(ctx, &managedIds, false)...)
+		routingRuleIds = managedOrder(currentOrder, managedIds)
+	}
+
+	routingRuleIdsValue, diags := types.ListValueFrom(ctx, types.StringType, routingRuleIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.TeamId
+	data.RoutingRuleIds = routingRuleIdsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *TeamRoutingRuleOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data dataModels.TeamRoutingRuleOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyOrder(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *TeamRoutingRuleOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The routing rules keep their current order, only the resource is removed from the state
+	tflog.Trace(ctx, "Deleted the TeamRoutingRuleOrderResource, the order of the routing rules is left as is")
+}
+
+func (r *TeamRoutingRuleOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), req.ID)...)
+}
+
+func (r *TeamRoutingRuleOrderResource) applyOrder(ctx context.Context, data *dataModels.TeamRoutingRuleOrderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var routingRuleIds []string
+	diags.Append(data.RoutingRuleIds.ElementsAs(ctx, &routingRuleIds, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	currentOrder, err := r.fetchOrder(data.TeamId.ValueString())
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read routing rules, %s", err))
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read routing rules, %s", err))
+		return diags
+	}
+
+	err = reorderIds(currentOrder, routingRuleIds, func(id string, order int) error {
+		tflog.Trace(ctx, fmt.Sprintf("Moving routing rule %s to %d", id, order))
+
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(r.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/routing-rules/%s/change-order", data.TeamId.ValueString(), id)).
+			Method(httpClient.POST).
+			SetBody(dto.RoutingRuleChangeOrderDto{Order: order}).
+			Send()
+
+		handleHttpResponse(httpResp, err, fmt.Sprintf("change the order of routing rule %s", id), &diags, ctx)
+		if diags.HasError() {
+			return fmt.Errorf("unable to change the order of routing rule %s", id)
+		}
+		return nil
+	})
+	if err != nil && !diags.HasError() {
+		diags.AddAttributeError(path.Root("routing_rule_ids"), "Invalid Routing Rule", fmt.Sprintf("Unable to order the routing rules of team %s, routing rule %s", data.TeamId.ValueString(), err))
+	}
+
+	data.Id = data.TeamId
+	return diags
+}
+
+// fetchOrder returns the IDs of the non-default routing rules of the team, in their current order.
+func (r *TeamRoutingRuleOrderResource) fetchOrder(teamId string) ([]string, error) {
+	routingRules, err := fetchAllPages[dto.RoutingRuleDto](r.clientConfiguration, fmt.Sprintf("/v1/teams/%s/routing-rules", teamId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(routingRules, func(i, j int) bool {
+		return routingRules[i].Order != nil && (routingRules[j].Order == nil || *routingRules[i].Order < *routingRules[j].Order)
+	})
+
+	ids := make([]string, 0, len(routingRules))
+	for _, routingRule := range routingRules {
+		if !routingRule.IsDefault {
+			ids = append(ids, routingRule.ID)
+		}
+	}
+	return ids, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -21,6 +22,7 @@ var (
 	_ resource.Resource                = &UserContactResource{}
 	_ resource.ResourceWithConfigure   = &UserContactResource{}
 	_ resource.ResourceWithImportState = &UserContactResource{}
+	_ resource.ResourceWithIdentity    = &UserContactResource{}
 )
 
 type UserContactResource struct {
@@ -41,6 +43,12 @@ func (r *UserContactResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *UserContactResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.IdIdentityAttributes,
+	}
+}
+
 func (r *UserContactResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring UserContactResource")
 
@@ -111,6 +119,7 @@ func (r *UserContactResource) Create(ctx context.Context, req resource.CreateReq
 	// Update state with response
 	result := UserContactCUDDtoToModel(&responseDto, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *UserContactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -157,6 +166,7 @@ func (r *UserContactResource) Read(ctx context.Context, req resource.ReadRequest
 
 	result := UserContactReadDtoToModel(&responseDto)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *UserContactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -231,6 +241,7 @@ func (r *UserContactResource) Update(ctx context.Context, req resource.UpdateReq
 
 	result := UserContactCUDDtoToModel(&responseDto, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *UserContactResource) updateMethodFinder(ctx context.Context, data *dataModels.UserContactModel, resp *resource.UpdateResponse) ([]string, error) {
@@ -331,5 +342,11 @@ func (r *UserContactResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *UserContactResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Imported by identity
+		resp.Diagnostics.Append(setStateFromIdentity(ctx, req.Identity, &resp.State)...)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -47,6 +47,12 @@ resource "atlassian-operations_user_contact" "example" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import by identity
+			{
+				ResourceName:    "atlassian-operations_user_contact.example",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
@@ -0,0 +1,673 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &UserNotificationProfileResource{}
+	_ resource.ResourceWithConfigure      = &UserNotificationProfileResource{}
+	_ resource.ResourceWithValidateConfig = &UserNotificationProfileResource{}
+	_ resource.ResourceWithModifyPlan     = &UserNotificationProfileResource{}
+	_ resource.ResourceWithImportState    = &UserNotificationProfileResource{}
+	_ resource.ResourceWithIdentity       = &UserNotificationProfileResource{}
+)
+
+// userNotificationProfileSendAfterActionTypes are the action types whose steps accept send_after.
+var userNotificationProfileSendAfterActionTypes = []string{"create-alert", "assigned-alert"}
+
+func NewUserNotificationProfileResource() resource.Resource {
+	return &UserNotificationProfileResource{}
+}
+
+// UserNotificationProfileResource manages the contacts and notification rules of a user as a whole. The contacts and
+// notification rules APIs act on behalf of the user owning the API token, so the profile of each user is managed
+// with a provider configured with the credentials of that user.
+type UserNotificationProfileResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (r *UserNotificationProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_notification_profile"
+	// Contacts are replaced when their method changes, which changes the contacts of the identity
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+func (r *UserNotificationProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the contacts and notification rules of a user as a whole, to apply the same notification setup to every on-call user. " +
+			"The contacts and notification rules are created for the user whose credentials the provider is configured with. " +
+			"Managing the profile on behalf of another user, e.g. by their account_id, is not supported.",
+		Attributes: schemaAttributes.UserNotificationProfileResourceAttributes,
+	}
+}
+
+func (r *UserNotificationProfileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.UserNotificationProfileIdentityAttributes,
+	}
+}
+
+func (r *UserNotificationProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring UserNotificationProfileResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured UserNotificationProfileResource")
+}
+
+// ValidateConfig checks that the steps of the rules reference the contacts of the profile, and only set send_after
+// for the action types accepting it.
+func (r *UserNotificationProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.UserNotificationProfileModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Rules.IsNull() || data.Rules.IsUnknown() {
+		return
+	}
+
+	var contacts map[string]dataModels.UserNotificationProfileContactModel
+	if !data.Contacts.IsNull() && !data.Contacts.IsUnknown() {
+		resp.Diagnostics.Append(data.Contacts.ElementsAs(ctx, &contacts, false)...)
+	}
+
+	var rules map[string]dataModels.UserNotificationProfileRuleModel
+	resp.Diagnostics.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for actionType, rule := range rules {
+		if rule.Steps.IsNull() || rule.Steps.IsUnknown() {
+			continue
+		}
+
+		var steps []dataModels.UserNotificationProfileStepModel
+		resp.Diagnostics.Append(rule.Steps.ElementsAs(ctx, &steps, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for i, step := range steps {
+			stepPath := path.Root("rules").AtMapKey(actionType).AtName("steps").AtListIndex(i)
+			if _, ok := contacts[step.Contact.ValueString()]; contacts != nil && !step.Contact.IsUnknown() && !ok {
+				resp.Diagnostics.AddAttributeError(stepPath.AtName("contact"), "Invalid Attribute",
+					fmt.Sprintf("The contact %s is not one of the contacts of the profile", step.Contact.ValueString()))
+			}
+			if !step.SendAfter.IsNull() && !slices.Contains(userNotificationProfileSendAfterActionTypes, actionType) {
+				resp.Diagnostics.AddAttributeError(stepPath.AtName("send_after"), "Invalid Attribute",
+					fmt.Sprintf("The send_after can only be set for the action types create-alert and assigned-alert, got: %s", actionType))
+			}
+		}
+	}
+}
+
+// ModifyPlan marks the contacts whose method changes as new contacts, as the method of a contact can't be updated.
+func (r *UserNotificationProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state dataModels.UserNotificationProfileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Contacts.IsUnknown() {
+		return
+	}
+
+	planContacts, diags := userNotificationProfileContacts(ctx, plan.Contacts)
+	resp.Diagnostics.Append(diags...)
+	stateContacts, diags := userNotificationProfileContacts(ctx, state.Contacts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, contact := range planContacts {
+		prior, ok := stateContacts[name]
+		if ok && !contact.Method.IsUnknown() && contact.Method.ValueString() != prior.Method.ValueString() {
+			contact.ID = types.StringUnknown()
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("contacts").AtMapKey(name), contact)...)
+		}
+	}
+}
+
+func (r *UserNotificationProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating UserNotificationProfileResource")
+
+	var data dataModels.UserNotificationProfileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planContacts, diags := userNotificationProfileContacts(ctx, data.Contacts)
+	resp.Diagnostics.Append(diags...)
+	planRules, diags := userNotificationProfileRules(ctx, data.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The state holds what was created so far, so that it is deleted when the creation fails midway
+	contacts := map[string]dataModels.UserNotificationProfileContactModel{}
+	rules := map[string]dataModels.UserNotificationProfileRuleModel{}
+	defer func() {
+		resp.Diagnostics.Append(setUserNotificationProfileState(ctx, &resp.State, data.Name, contacts, rules)...)
+		resp.Diagnostics.Append(setUserNotificationProfileIdentity(ctx, resp.Identity, data.Name, contacts, rules)...)
+	}()
+
+	for _, name := range sortedKeys(planContacts) {
+		contact := planContacts[name]
+		contact.ID = r.createContact(ctx, contact, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		contacts[name] = contact
+	}
+
+	for _, actionType := range sortedKeys(planRules) {
+		rule := planRules[actionType]
+		rule.ID = r.saveRule(ctx, data.Name.ValueString(), actionType, rule, "", contacts, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		rules[actionType] = rule
+	}
+}
+
+func (r *UserNotificationProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "Reading UserNotificationProfileResource")
+
+	var data dataModels.UserNotificationProfileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateContacts, diags := userNotificationProfileContacts(ctx, data.Contacts)
+	resp.Diagnostics.Append(diags...)
+	stateRules, diags := userNotificationProfileRules(ctx, data.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The contacts and rules deleted outside of Terraform are removed from the profile, to be created again
+	contacts := map[string]dataModels.UserNotificationProfileContactModel{}
+	for name, contact := range stateContacts {
+		var contactDto dto.UserContactDataReadResponseDto
+		if !r.read(ctx, fmt.Sprintf("/v1/users/contacts/%s", contact.ID.ValueString()), &contactDto, "read user contact", &resp.Diagnostics) {
+			continue
+		}
+		contacts[name] = dataModels.UserNotificationProfileContactModel{
+			ID:      contact.ID,
+			Method:  types.StringValue(contactDto.Method),
+			To:      types.StringValue(contactDto.To),
+			Enabled: types.BoolValue(contactDto.Status.Enabled),
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules := map[string]dataModels.UserNotificationProfileRuleModel{}
+	for actionType, rule := range stateRules {
+		var ruleDto dto.NotificationRuleDto
+		if !r.read(ctx, fmt.Sprintf("/v1/notification-rules/%s", rule.ID.ValueString()), &ruleDto, "read notification rule", &resp.Diagnostics) {
+			continue
+		}
+
+		steps := make([]attr.Value, len(ruleDto.Steps))
+		for i, step := range ruleDto.Steps {
+			steps[i] = dataModels.UserNotificationProfileStepModel{
+				Contact:   types.StringValue(userNotificationProfileContactName(contacts, step.Contact)),
+				SendAfter: types.Int64PointerValue(step.SendAfter),
+			}.AsValue()
+		}
+		rule.Steps = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.UserNotificationProfileStepModelMap}, steps)
+		rule.Enabled = types.BoolValue(ruleDto.Enabled)
+		rules[actionType] = rule
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setUserNotificationProfileState(ctx, &resp.State, data.Name, contacts, rules)...)
+	resp.Diagnostics.Append(setUserNotificationProfileIdentity(ctx, resp.Identity, data.Name, contacts, rules)...)
+}
+
+func (r *UserNotificationProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "Updating UserNotificationProfileResource")
+
+	var plan, state dataModels.UserNotificationProfileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planContacts, diags := userNotificationProfileContacts(ctx, plan.Contacts)
+	resp.Diagnostics.Append(diags...)
+	planRules, diags := userNotificationProfileRules(ctx, plan.Rules)
+	resp.Diagnostics.Append(diags...)
+	stateContacts, diags := userNotificationProfileContacts(ctx, state.Contacts)
+	resp.Diagnostics.Append(diags...)
+	stateRules, diags := userNotificationProfileRules(ctx, state.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The state holds the prior contacts and rules, replaced as they are updated, so that it stays accurate when the
+	// update fails midway
+	contacts := maps.Clone(stateContacts)
+	rules := maps.Clone(stateRules)
+	defer func() {
+		resp.Diagnostics.Append(setUserNotificationProfileState(ctx, &resp.State, plan.Name, contacts, rules)...)
+		resp.Diagnostics.Append(setUserNotificationProfileIdentity(ctx, resp.Identity, plan.Name, contacts, rules)...)
+	}()
+
+	// Contacts whose method changed are replaced by a new contact, and deleted once the rules don't use them anymore
+	var replacedContacts []string
+	for _, name := range sortedKeys(planContacts) {
+		contact := planContacts[name]
+		prior, ok := stateContacts[name]
+		if ok && prior.Method.ValueString() == contact.Method.ValueString() {
+			contact.ID = prior.ID
+			r.updateContact(ctx, contact, prior, &resp.Diagnostics)
+		} else {
+			contact.ID = r.createContact(ctx, contact, &resp.Diagnostics)
+			if ok {
+				replacedContacts = append(replacedContacts, name)
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		contacts[name] = contact
+	}
+
+	for _, actionType := range sortedKeys(planRules) {
+		rule := planRules[actionType]
+		rule.ID = r.saveRule(ctx, plan.Name.ValueString(), actionType, rule, stateRules[actionType].ID.ValueString(), contacts, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		rules[actionType] = rule
+	}
+
+	for _, actionType := range sortedKeys(stateRules) {
+		if _, ok := planRules[actionType]; ok {
+			continue
+		}
+		r.delete(ctx, fmt.Sprintf("/v1/notification-rules/%s", stateRules[actionType].ID.ValueString()), "delete notification rule", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		delete(rules, actionType)
+	}
+
+	for _, name := range sortedKeys(stateContacts) {
+		if _, ok := planContacts[name]; ok && !slices.Contains(replacedContacts, name) {
+			continue
+		}
+		r.delete(ctx, fmt.Sprintf("/v1/users/contacts/%s", stateContacts[name].ID.ValueString()), "delete user contact", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !slices.Contains(replacedContacts, name) {
+			delete(contacts, name)
+		}
+	}
+}
+
+func (r *UserNotificationProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "Deleting UserNotificationProfileResource")
+
+	var data dataModels.UserNotificationProfileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contacts, diags := userNotificationProfileContacts(ctx, data.Contacts)
+	resp.Diagnostics.Append(diags...)
+	rules, diags := userNotificationProfileRules(ctx, data.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The rules are deleted first, as they notify the contacts
+	for _, actionType := range sortedKeys(rules) {
+		r.delete(ctx, fmt.Sprintf("/v1/notification-rules/%s", rules[actionType].ID.ValueString()), "delete notification rule", &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, name := range sortedKeys(contacts) {
+		r.delete(ctx, fmt.Sprintf("/v1/users/contacts/%s", contacts[name].ID.ValueString()), "delete user contact", &resp.Diagnostics)
+	}
+}
+
+// ImportState imports a profile from its name, its contacts as contact_name=contact_id and the IDs of its rules, as
+// the contacts and rules of a profile can't be told apart from the other contacts and rules of the user otherwise.
+// The rules are read to find their action type, and the rest of the profile is read by Read.
+func (r *UserNotificationProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var name string
+	var contactIds, ruleIds []string
+	if req.ID == "" {
+		// Imported by identity
+		var identity struct {
+			Name     string   `tfsdk:"name"`
+			Contacts []string `tfsdk:"contacts"`
+			RuleIds  []string `tfsdk:"rule_ids"`
+		}
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		name, contactIds, ruleIds = identity.Name, identity.Contacts, identity.RuleIds
+	} else {
+		idParts := strings.Split(req.ID, ",")
+		name = idParts[0]
+		for _, idPart := range idParts[1:] {
+			if strings.Contains(idPart, "=") {
+				contactIds = append(contactIds, idPart)
+			} else {
+				ruleIds = append(ruleIds, idPart)
+			}
+		}
+	}
+
+	contacts := map[string]dataModels.UserNotificationProfileContactModel{}
+	for _, contactId := range contactIds {
+		contactName, id, _ := strings.Cut(contactId, "=")
+		if contactName == "" || id == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected the contacts of the profile with format: contact_name=contact_id. Got: %q", contactId),
+			)
+			return
+		}
+		contacts[contactName] = dataModels.UserNotificationProfileContactModel{
+			ID:      types.StringValue(id),
+			Method:  types.StringNull(),
+			To:      types.StringNull(),
+			Enabled: types.BoolNull(),
+		}
+	}
+	if (name == "" || len(contacts) == 0) && req.ID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identity",
+			fmt.Sprintf("Expected an identity with a name and at least one contact. Got name: %q, contacts: %q", name, contactIds),
+		)
+		return
+	}
+	if name == "" || len(contacts) == 0 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,contact_name=contact_id,...,rule_id,... Got: %q", req.ID),
+		)
+		return
+	}
+
+	rules := map[string]dataModels.UserNotificationProfileRuleModel{}
+	for _, ruleId := range ruleIds {
+		var ruleDto dto.NotificationRuleDto
+		if !r.read(ctx, fmt.Sprintf("/v1/notification-rules/%s", ruleId), &ruleDto, "read notification rule", &resp.Diagnostics) {
+			if !resp.Diagnostics.HasError() {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import notification rule, the rule %s does not exist", ruleId))
+			}
+			return
+		}
+		if _, ok := rules[ruleDto.ActionType]; ok {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to import notification rule %s, the profile has several rules for the action type %s", ruleId, ruleDto.ActionType))
+			return
+		}
+		rules[ruleDto.ActionType] = dataModels.UserNotificationProfileRuleModel{
+			ID:      types.StringValue(ruleId),
+			Steps:   types.ListNull(types.ObjectType{AttrTypes: dataModels.UserNotificationProfileStepModelMap}),
+			Enabled: types.BoolNull(),
+		}
+	}
+
+	resp.Diagnostics.Append(setUserNotificationProfileState(ctx, &resp.State, types.StringValue(name), contacts, rules)...)
+	resp.Diagnostics.Append(setUserNotificationProfileIdentity(ctx, resp.Identity, types.StringValue(name), contacts, rules)...)
+}
+
+// createContact creates a user contact and returns its ID.
+func (r *UserNotificationProfileResource) createContact(ctx context.Context, contact dataModels.UserNotificationProfileContactModel, diags *diag.Diagnostics) types.String {
+	var responseDto dto.UserContactCUDResponseDto
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl("/v1/users/contacts").
+		Method(httpClient.POST).
+		SetBody(dto.UserContactDto{
+			Method:  contact.Method.ValueString(),
+			To:      contact.To.ValueString(),
+			Enabled: contact.Enabled.ValueBool(),
+		}).
+		SetBodyParseObject(&responseDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "create user contact", diags, ctx)
+	return types.StringValue(responseDto.Data.ID)
+}
+
+// updateContact updates the recipient of a user contact, and activates or deactivates it.
+func (r *UserNotificationProfileResource) updateContact(ctx context.Context, contact dataModels.UserNotificationProfileContactModel, prior dataModels.UserNotificationProfileContactModel, diags *diag.Diagnostics) {
+	endpoint := fmt.Sprintf("/v1/users/contacts/%s", contact.ID.ValueString())
+
+	if contact.To.ValueString() != prior.To.ValueString() {
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(r.clientConfiguration).
+			JoinBaseUrl(endpoint).
+			Method(httpClient.PATCH).
+			SetBody(dto.UserContactDto{
+				Method:  contact.Method.ValueString(),
+				To:      contact.To.ValueString(),
+				Enabled: contact.Enabled.ValueBool(),
+			}).
+			Send()
+
+		handleHttpResponse(httpResp, err, "update user contact", diags, ctx)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	if contact.Enabled.ValueBool() != prior.Enabled.ValueBool() {
+		if contact.Enabled.ValueBool() {
+			endpoint += "/activate"
+		} else {
+			endpoint += "/deactivate"
+		}
+
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(r.clientConfiguration).
+			JoinBaseUrl(endpoint).
+			Method(httpClient.PATCH).
+			Send()
+
+		handleHttpResponse(httpResp, err, "update user contact", diags, ctx)
+	}
+}
+
+// saveRule creates the notification rule of an action type, or updates it when id is set, and returns its ID.
+func (r *UserNotificationProfileResource) saveRule(ctx context.Context, name string, actionType string, rule dataModels.UserNotificationProfileRuleModel, id string, contacts map[string]dataModels.UserNotificationProfileContactModel, diags *diag.Diagnostics) types.String {
+	var steps []dataModels.UserNotificationProfileStepModel
+	diags.Append(rule.Steps.ElementsAs(ctx, &steps, false)...)
+	if diags.HasError() {
+		return types.StringNull()
+	}
+
+	ruleDto := dto.NotificationRuleDto{
+		Name:       fmt.Sprintf("%s - %s", name, actionType),
+		ActionType: actionType,
+		Criteria:   &dto.CriteriaDto{Type: dto.MatchAll},
+		Enabled:    rule.Enabled.ValueBool(),
+	}
+	for _, step := range steps {
+		contact := contacts[step.Contact.ValueString()]
+		ruleDto.Steps = append(ruleDto.Steps, dto.NotificationRuleStep{
+			SendAfter: step.SendAfter.ValueInt64Pointer(),
+			Contact: dto.NotificationContact{
+				Method: contact.Method.ValueString(),
+				To:     contact.To.ValueString(),
+			},
+			Enabled: true,
+		})
+	}
+
+	request := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		SetBody(ruleDto).
+		SetBodyParseObject(&ruleDto)
+	if id == "" {
+		request = request.JoinBaseUrl("/v1/notification-rules").Method(httpClient.POST)
+	} else {
+		request = request.JoinBaseUrl(fmt.Sprintf("/v1/notification-rules/%s", id)).Method(httpClient.PATCH)
+	}
+	httpResp, err := request.Send()
+
+	handleHttpResponse(httpResp, err, "save notification rule", diags, ctx)
+	return types.StringValue(ruleDto.ID)
+}
+
+// read reads a contact or a rule of the profile, and tells whether it was found.
+func (r *UserNotificationProfileResource) read(ctx context.Context, endpoint string, target interface{}, action string, diags *diag.Diagnostics) bool {
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(endpoint).
+		Method(httpClient.GET).
+		SetBodyParseObject(target).
+		Send()
+
+	if httpResp != nil && httpResp.IsError() && httpResp.GetStatusCode() == 404 {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to %s, it was deleted outside of Terraform", action))
+		return false
+	}
+
+	var readDiags diag.Diagnostics
+	handleHttpResponse(httpResp, err, action, &readDiags, ctx)
+	diags.Append(readDiags...)
+	return !readDiags.HasError()
+}
+
+// delete deletes a contact or a rule of the profile, ignoring the ones already deleted.
+func (r *UserNotificationProfileResource) delete(ctx context.Context, endpoint string, action string, diags *diag.Diagnostics) {
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(endpoint).
+		Method(httpClient.DELETE).
+		Send()
+
+	if httpResp != nil && httpResp.IsError() && httpResp.GetStatusCode() == 404 {
+		return
+	}
+	handleHttpResponse(httpResp, err, action, diags, ctx)
+}
+
+// userNotificationProfileContactName returns the name of the contact a step notifies, or the method and recipient of
+// the step when it notifies a contact which is not part of the profile.
+func userNotificationProfileContactName(contacts map[string]dataModels.UserNotificationProfileContactModel, contact dto.NotificationContact) string {
+	for _, name := range sortedKeys(contacts) {
+		if contacts[name].Method.ValueString() == contact.Method && contacts[name].To.ValueString() == contact.To {
+			return name
+		}
+	}
+	return fmt.Sprintf("%s:%s", contact.Method, contact.To)
+}
+
+func userNotificationProfileContacts(ctx context.Context, value types.Map) (map[string]dataModels.UserNotificationProfileContactModel, diag.Diagnostics) {
+	contacts := map[string]dataModels.UserNotificationProfileContactModel{}
+	if value.IsNull() || value.IsUnknown() {
+		return contacts, nil
+	}
+	diags := value.ElementsAs(ctx, &contacts, false)
+	return contacts, diags
+}
+
+func userNotificationProfileRules(ctx context.Context, value types.Map) (map[string]dataModels.UserNotificationProfileRuleModel, diag.Diagnostics) {
+	rules := map[string]dataModels.UserNotificationProfileRuleModel{}
+	if value.IsNull() || value.IsUnknown() {
+		return rules, nil
+	}
+	diags := value.ElementsAs(ctx, &rules, false)
+	return rules, diags
+}
+
+func setUserNotificationProfileState(ctx context.Context, state *tfsdk.State, name types.String, contacts map[string]dataModels.UserNotificationProfileContactModel, rules map[string]dataModels.UserNotificationProfileRuleModel) diag.Diagnostics {
+	contactValues := make(map[string]attr.Value, len(contacts))
+	for contactName, contact := range contacts {
+		contactValues[contactName] = contact.AsValue()
+	}
+	ruleValues := make(map[string]attr.Value, len(rules))
+	for actionType, rule := range rules {
+		ruleValues[actionType] = rule.AsValue()
+	}
+
+	data := dataModels.UserNotificationProfileModel{
+		ID:       name,
+		Name:     name,
+		Contacts: types.MapValueMust(types.ObjectType{AttrTypes: dataModels.UserNotificationProfileContactModelMap}, contactValues),
+		Rules:    types.MapValueMust(types.ObjectType{AttrTypes: dataModels.UserNotificationProfileRuleModelMap}, ruleValues),
+	}
+	return state.Set(ctx, &data)
+}
+
+// setUserNotificationProfileIdentity sets the identity of a profile to its name, its contacts and the IDs of its rules.
+func setUserNotificationProfileIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, name types.String, contacts map[string]dataModels.UserNotificationProfileContactModel, rules map[string]dataModels.UserNotificationProfileRuleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+
+	contactIds := make([]string, 0, len(contacts))
+	for _, contactName := range sortedKeys(contacts) {
+		contactIds = append(contactIds, fmt.Sprintf("%s=%s", contactName, contacts[contactName].ID.ValueString()))
+	}
+	ruleIds := make([]string, 0, len(rules))
+	for _, actionType := range sortedKeys(rules) {
+		ruleIds = append(ruleIds, rules[actionType].ID.ValueString())
+	}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root("name"), name)...)
+	diags.Append(identity.SetAttribute(ctx, path.Root("contacts"), contactIds)...)
+	diags.Append(identity.SetAttribute(ctx, path.Root("rule_ids"), ruleIds)...)
+	return diags
+}
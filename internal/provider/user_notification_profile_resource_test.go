@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccUserNotificationProfileResource(t *testing.T) {
+	profileName := uuid.NewString()
+	workEmail := "work-" + profileName + "@example.com"
+	homeEmail := "home-" + profileName + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Steps referencing a contact which is not part of the profile are rejected
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_user_notification_profile" "example" {
+  name = "` + profileName + `"
+
+  contacts = {
+    work = {
+      method = "email"
+      to     = "` + workEmail + `"
+    }
+  }
+
+  rules = {
+    "create-alert" = {
+      steps = [
+        {
+          contact = "home"
+        }
+      ]
+    }
+  }
+}`,
+				ExpectError: regexp.MustCompile(`The\s+contact\s+home\s+is\s+not\s+one\s+of\s+the\s+contacts`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_user_notification_profile" "example" {
+  name = "` + profileName + `"
+
+  contacts = {
+    work = {
+      method = "email"
+      to     = "` + workEmail + `"
+    }
+  }
+
+  rules = {
+    "create-alert" = {
+      steps = [
+        {
+          contact = "work"
+        }
+      ]
+    }
+    "closed-alert" = {
+      steps = [
+        {
+          contact = "work"
+        }
+      ]
+    }
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_user_notification_profile.example", "id", profileName),
+					resource.TestCheckResourceAttrSet("atlassian-operations_user_notification_profile.example", "contacts.work.id"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_notification_profile.example", "contacts.work.enabled", "true"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_user_notification_profile.example", "rules.create-alert.id"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_user_notification_profile.example", "rules.closed-alert.id"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_notification_profile.example", "rules.create-alert.steps.0.contact", "work"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_user_notification_profile" "example" {
+  name = "` + profileName + `"
+
+  contacts = {
+    work = {
+      method  = "email"
+      to      = "` + workEmail + `"
+      enabled = false
+    }
+    home = {
+      method = "email"
+      to     = "` + homeEmail + `"
+    }
+  }
+
+  rules = {
+    "create-alert" = {
+      steps = [
+        {
+          contact = "home"
+        },
+        {
+          contact    = "work"
+          send_after = 15
+        }
+      ]
+    }
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_user_notification_profile.example", "contacts.%", "2"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_notification_profile.example", "contacts.work.enabled", "false"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_notification_profile.example", "contacts.home.to", homeEmail),
+					resource.TestCheckResourceAttr("atlassian-operations_user_notification_profile.example", "rules.%", "1"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_notification_profile.example", "rules.create-alert.steps.#", "2"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_notification_profile.example", "rules.create-alert.steps.0.contact", "home"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_notification_profile.example", "rules.create-alert.steps.1.send_after", "15"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_user_notification_profile.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					attributes := state.RootModule().Resources["atlassian-operations_user_notification_profile.example"].Primary.Attributes
+					return attributes["name"] +
+							",work=" + attributes["contacts.work.id"] +
+							",home=" + attributes["contacts.home.id"] +
+							"," + attributes["rules.create-alert.id"],
+						nil
+				},
+			},
+			// Import by identity
+			{
+				ResourceName:    "atlassian-operations_user_notification_profile.example",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
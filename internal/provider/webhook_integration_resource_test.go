@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWebhookIntegrationResource(t *testing.T) {
+	webhookIntegrationName := uuid.NewString()
+	webhookIntegrationUpdateName := uuid.NewString()
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_webhook_integration" "example" {
+  name    = "` + webhookIntegrationName + `"
+  team_id = atlassian-operations_team.example.id
+  url     = "https://example.com/webhook"
+  headers = {
+    Authorization = "Bearer secret-token"
+  }
+  add_alert_description = true
+  add_alert_details     = false
+  alert_filter = {
+    type = "match-all-conditions"
+    conditions = [
+      {
+        field          = "priority"
+        operation      = "equals"
+        expected_value = "P1"
+      }
+    ]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "name", webhookIntegrationName),
+					resource.TestCheckResourceAttrPair("atlassian-operations_webhook_integration.example", "team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "enabled", "true"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "url", "https://example.com/webhook"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "headers.Authorization", "Bearer secret-token"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "add_alert_description", "true"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "add_alert_details", "false"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "alert_filter.type", "match-all-conditions"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "alert_filter.conditions.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "atlassian-operations_webhook_integration.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"directions.#", "domains.#", "domains.0", "directions.0", "headers.%", "headers.Authorization"},
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_webhook_integration.example"].Primary.ID,
+						nil
+				},
+			},
+			// Import by identity, the directions, domains and headers are not read back from the API so an update is planned
+			{
+				ResourceName:       "atlassian-operations_webhook_integration.example",
+				ImportState:        true,
+				ImportStateKind:    resource.ImportBlockWithResourceIdentity,
+				ExpectNonEmptyPlan: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_webhook_integration" "example" {
+  name    = "` + webhookIntegrationUpdateName + `"
+  team_id = atlassian-operations_team.example.id
+  enabled = false
+  url     = "https://example.com/webhook/updated"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "name", webhookIntegrationUpdateName),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "enabled", "false"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "url", "https://example.com/webhook/updated"),
+					resource.TestCheckNoResourceAttr("atlassian-operations_webhook_integration.example", "headers.%"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "add_alert_description", "true"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "add_alert_details", "true"),
+					resource.TestCheckNoResourceAttr("atlassian-operations_webhook_integration.example", "alert_filter.type"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
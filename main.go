@@ -6,9 +6,11 @@ package main
 import (
 	"context"
 	"flag"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/migration"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider"
 
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
@@ -24,10 +26,28 @@ var (
 
 func main() {
 	var debug bool
+	var export bool
+	var migrateOpsgenie string
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.BoolVar(&export, "export", false, "set to true to write the configuration and import blocks of every object of the site to the standard output instead of running the provider, requires ATLASSIAN_OPS_ORGANIZATION_ID")
+	flag.StringVar(&migrateOpsgenie, "migrate-opsgenie", "", "path of an Opsgenie provider configuration or state file to convert into atlassian-operations configuration, written to the standard output")
 	flag.Parse()
 
+	if export {
+		if err := provider.Export(context.Background(), version, os.Stdout); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
+	if migrateOpsgenie != "" {
+		if err := migration.Migrate(migrateOpsgenie, os.Stdout, os.Stderr); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	opts := providerserver.ServeOpts{
 		// TODO: Update this string with the published name of your provider.
 		// Also update the tfplugindocs generate command to either remove the